@@ -0,0 +1,204 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections
+
+import (
+	"errors"
+	"fmt"
+)
+
+// BiMapPolicy decides what BiMap.Put does when the value is already mapped
+// from a different key.
+type BiMapPolicy int
+
+const (
+	// BiMapReject makes Put return an error wrapping ErrBiMapConflict and
+	// leave the map unchanged.
+	BiMapReject BiMapPolicy = iota
+	// BiMapOverwrite makes Put remove the other key's entry, so the value
+	// moves to the new key.
+	BiMapOverwrite
+)
+
+var ErrBiMapConflict = errors.New("collections: value is already mapped from another key")
+
+// BiMap is a one-to-one map that can be looked up by key or by value. Each
+// key maps to exactly one value and each value to exactly one key, and the
+// two directions are always kept in sync.
+//
+// A BiMap is not safe for concurrent use.
+type BiMap[K comparable, V comparable] struct {
+	forward  map[K]V
+	backward map[V]K
+	policy   BiMapPolicy
+	inverse  *BiMap[V, K]
+}
+
+// Initialize a new, empty BiMap that handles conflicting values in Put
+// according to policy.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: 2 maps
+func NewBiMap[K comparable, V comparable](policy BiMapPolicy) *BiMap[K, V] {
+	return &BiMap[K, V]{
+		forward:  map[K]V{},
+		backward: map[V]K{},
+		policy:   policy,
+	}
+}
+
+// Map key to value. If key already had a different value, that value is
+// released. If value is already mapped from a different key, the policy
+// decides whether Put fails or takes the value from the other key.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: Map resize
+func (b *BiMap[K, V]) Put(key K, value V) error {
+	if otherKey, ok := b.backward[value]; ok && otherKey != key {
+		if b.policy == BiMapReject {
+			return fmt.Errorf("%w: %v is mapped from %v", ErrBiMapConflict, value, otherKey)
+		}
+		delete(b.forward, otherKey)
+	}
+	if oldValue, ok := b.forward[key]; ok {
+		delete(b.backward, oldValue)
+	}
+	b.forward[key] = value
+	b.backward[value] = key
+	return nil
+}
+
+// Get the value mapped from a key.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (b *BiMap[K, V]) GetByKey(key K) (V, bool) {
+	value, ok := b.forward[key]
+	return value, ok
+}
+
+// Get the key that maps to a value.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (b *BiMap[K, V]) GetByValue(value V) (K, bool) {
+	key, ok := b.backward[value]
+	return key, ok
+}
+
+// Check if the map contains a key.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (b *BiMap[K, V]) ContainsKey(key K) bool {
+	_, ok := b.forward[key]
+	return ok
+}
+
+// Check if the map contains a value.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (b *BiMap[K, V]) ContainsValue(value V) bool {
+	_, ok := b.backward[value]
+	return ok
+}
+
+// Remove a key and its value. Returns false if the key was not present.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (b *BiMap[K, V]) DeleteByKey(key K) bool {
+	value, ok := b.forward[key]
+	if !ok {
+		return false
+	}
+	delete(b.forward, key)
+	delete(b.backward, value)
+	return true
+}
+
+// Remove a value and its key. Returns false if the value was not present.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (b *BiMap[K, V]) DeleteByValue(value V) bool {
+	key, ok := b.backward[value]
+	if !ok {
+		return false
+	}
+	delete(b.backward, value)
+	delete(b.forward, key)
+	return true
+}
+
+// Get the number of entries in the map.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (b *BiMap[K, V]) Len() int {
+	return len(b.forward)
+}
+
+// Get a view of the map with keys and values swapped. The view shares
+// storage with the original, so changes made through either are seen by
+// both, and it uses the same policy.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: 1 BiMap header the first time it is called.
+func (b *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	if b.inverse == nil {
+		b.inverse = &BiMap[V, K]{
+			forward:  b.backward,
+			backward: b.forward,
+			policy:   b.policy,
+			inverse:  b,
+		}
+	}
+	return b.inverse
+}
+
+// Create a new Set with every key.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 1 set, n elements.
+func (b *BiMap[K, V]) KeySet() Set[K] {
+	return MapKeysSet(b.forward)
+}
+
+// Create a new Set with every value.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 1 set, n elements.
+func (b *BiMap[K, V]) ValueSet() Set[V] {
+	return MapKeysSet(b.backward)
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RageCage64/collections-go"
+	"github.com/RageCage64/go-assert"
+)
+
+func TestBiMap(t *testing.T) {
+	b := collections.NewBiMap[string, int](collections.BiMapReject)
+	assert.NilErr(t, b.Put("alice", 1))
+	assert.NilErr(t, b.Put("bob", 2))
+	assert.NilErr(t, b.Put("bob", 2))
+	assert.Equal(t, 2, b.Len())
+
+	id, ok := b.GetByKey("alice")
+	assert.Assert(t, ok, "expected alice to be present")
+	assert.Equal(t, 1, id)
+	name, ok := b.GetByValue(2)
+	assert.Assert(t, ok, "expected 2 to be present")
+	assert.Equal(t, "bob", name)
+	_, ok = b.GetByKey("carol")
+	assert.Assert(t, !ok, "expected carol not to be present")
+
+	// Giving a key a new value releases the old one.
+	assert.NilErr(t, b.Put("bob", 3))
+	assert.Assert(t, !b.ContainsValue(2), "expected 2 to be released")
+	assert.Assert(t, b.ContainsKey("bob"), "expected bob to be present")
+
+	assert.Assert(t, b.DeleteByKey("alice"), "expected alice to be deleted")
+	assert.Assert(t, !b.ContainsValue(1), "expected 1 to be deleted with alice")
+	assert.Assert(t, b.DeleteByValue(3), "expected 3 to be deleted")
+	assert.Assert(t, !b.ContainsKey("bob"), "expected bob to be deleted with 3")
+	assert.Assert(t, !b.DeleteByKey("alice"), "expected alice to already be deleted")
+	assert.Assert(t, !b.DeleteByValue(3), "expected 3 to already be deleted")
+	assert.Equal(t, 0, b.Len())
+}
+
+func TestBiMapReject(t *testing.T) {
+	b := collections.NewBiMap[string, int](collections.BiMapReject)
+	assert.NilErr(t, b.Put("alice", 1))
+	err := b.Put("bob", 1)
+	assert.Assert(t, errors.Is(err, collections.ErrBiMapConflict), "expected ErrBiMapConflict, got %v", err)
+	assert.Assert(t, !b.ContainsKey("bob"), "expected bob not to be added")
+	name, _ := b.GetByValue(1)
+	assert.Equal(t, "alice", name)
+}
+
+func TestBiMapOverwrite(t *testing.T) {
+	b := collections.NewBiMap[string, int](collections.BiMapOverwrite)
+	assert.NilErr(t, b.Put("alice", 1))
+	assert.NilErr(t, b.Put("bob", 2))
+	assert.NilErr(t, b.Put("bob", 1))
+	assert.Equal(t, 1, b.Len())
+	assert.Assert(t, !b.ContainsKey("alice"), "expected alice to lose 1")
+	assert.Assert(t, !b.ContainsValue(2), "expected 2 to be released")
+	name, _ := b.GetByValue(1)
+	assert.Equal(t, "bob", name)
+}
+
+func TestBiMapInverse(t *testing.T) {
+	b := collections.NewBiMap[string, int](collections.BiMapReject)
+	assert.NilErr(t, b.Put("alice", 1))
+
+	inv := b.Inverse()
+	name, _ := inv.GetByKey(1)
+	assert.Equal(t, "alice", name)
+	assert.Assert(t, inv.Inverse() == b, "expected the inverse of the inverse to be the original")
+
+	assert.NilErr(t, inv.Put(2, "bob"))
+	id, _ := b.GetByKey("bob")
+	assert.Equal(t, 2, id)
+
+	err := inv.Put(3, "bob")
+	assert.Assert(t, errors.Is(err, collections.ErrBiMapConflict), "expected ErrBiMapConflict, got %v", err)
+
+	assert.Assert(t, inv.DeleteByKey(2), "expected 2 to be deleted through the inverse")
+	assert.Assert(t, !b.ContainsKey("bob"), "expected bob to be deleted")
+	assert.Equal(t, 1, b.Len())
+}
+
+func TestBiMapSets(t *testing.T) {
+	b := collections.NewBiMap[string, int](collections.BiMapReject)
+	assert.NilErr(t, b.Put("alice", 1))
+	assert.NilErr(t, b.Put("bob", 2))
+	assert.Assert(t, b.KeySet().Equals(collections.NewSet("alice", "bob")), "unexpected key set %v", b.KeySet())
+	assert.Assert(t, b.ValueSet().Equals(collections.NewSet(1, 2)), "unexpected value set %v", b.ValueSet())
+}
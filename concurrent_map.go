@@ -0,0 +1,492 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/maphash"
+	"math"
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+// DefaultConcurrentMapShards is the number of shards used by
+// NewConcurrentMap.
+const DefaultConcurrentMapShards = 32
+
+// ConcurrentMap is a map that is safe for concurrent use, split across a
+// fixed number of shards that each have their own lock. Operations on keys in
+// different shards never wait on each other. Unlike sync.Map, the keys and
+// values are typed.
+//
+// ComputeIfAbsent runs its callback without holding any lock, and callers
+// asking for the same missing key wait for the first one to finish instead
+// of running the callback again. This makes it suitable for filling a cache
+// with values that are expensive to create.
+//
+// Keys whose underlying type is a string, bool, number, pointer or channel
+// are hashed without allocating. Composite keys, meaning structs, arrays and
+// interfaces, are hashed with reflection, which costs 1 allocation for every
+// operation that looks up a key.
+type ConcurrentMap[K comparable, V any] struct {
+	shards []concurrentMapShard[K, V]
+	mask   uint64
+	hash   func(K) uint64
+}
+
+type concurrentMapShard[K comparable, V any] struct {
+	mu      sync.RWMutex
+	entries map[K]V
+	// pending holds the ComputeIfAbsent calls that are running, so that
+	// other callers for the same key can wait for them.
+	pending map[K]*pendingCompute
+}
+
+type pendingCompute struct {
+	done chan struct{}
+}
+
+// Initialize a new ConcurrentMap with DefaultConcurrentMapShards shards.
+//
+// Time Complexity: O(s) (where s = number of shards)
+// Space Complexity: O(s)
+// Allocations: 1 shard slice, s maps
+func NewConcurrentMap[K comparable, V any]() *ConcurrentMap[K, V] {
+	return NewConcurrentMapWithShards[K, V](DefaultConcurrentMapShards)
+}
+
+// Initialize a new ConcurrentMap with the given number of shards, rounded up
+// to a power of 2. More shards mean less waiting when many goroutines write
+// at once, at the cost of a slower Snapshot and Len. Uses 1 shard if shards
+// is zero or negative.
+//
+// Time Complexity: O(s) (where s = number of shards)
+// Space Complexity: O(s)
+// Allocations: 1 shard slice, s maps
+func NewConcurrentMapWithShards[K comparable, V any](shards int) *ConcurrentMap[K, V] {
+	n := 1
+	for n < shards {
+		n <<= 1
+	}
+	m := &ConcurrentMap[K, V]{
+		shards: make([]concurrentMapShard[K, V], n),
+		mask:   uint64(n - 1),
+		hash:   newKeyHasher[K](maphash.MakeSeed()),
+	}
+	for i := range m.shards {
+		m.shards[i].entries = map[K]V{}
+		m.shards[i].pending = map[K]*pendingCompute{}
+	}
+	return m
+}
+
+func (m *ConcurrentMap[K, V]) shard(key K) *concurrentMapShard[K, V] {
+	return &m.shards[m.hash(key)&m.mask]
+}
+
+// Get the value stored for a key, and whether it was present.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None, or 1 for composite keys (see ConcurrentMap)
+func (m *ConcurrentMap[K, V]) Load(key K) (V, bool) {
+	s := m.shard(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	value, ok := s.entries[key]
+	return value, ok
+}
+
+// Set the value for a key.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: Shard resize. 1 for composite keys (see ConcurrentMap)
+func (m *ConcurrentMap[K, V]) Store(key K, value V) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[key] = value
+}
+
+// Remove a key from the map.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None, or 1 for composite keys (see ConcurrentMap)
+func (m *ConcurrentMap[K, V]) Delete(key K) {
+	m.LoadAndDelete(key)
+}
+
+// Get the value stored for a key if it is present. Otherwise, store the
+// given value and return it. The bool is true if the value was loaded and
+// false if it was stored.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: Shard resize. 1 for composite keys (see ConcurrentMap)
+func (m *ConcurrentMap[K, V]) LoadOrStore(key K, value V) (V, bool) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if actual, ok := s.entries[key]; ok {
+		return actual, true
+	}
+	s.entries[key] = value
+	return value, false
+}
+
+// Remove a key from the map, returning the value it had and whether it was
+// present.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None, or 1 for composite keys (see ConcurrentMap)
+func (m *ConcurrentMap[K, V]) LoadAndDelete(key K) (V, bool) {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	value, ok := s.entries[key]
+	if ok {
+		delete(s.entries, key)
+	}
+	return value, ok
+}
+
+// Replace the value for a key with new, but only if the key is present and
+// its value is equal to old. Returns whether the value was replaced. Panics
+// if V is not a comparable type, the same as sync.Map.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None, or 1 for composite keys (see ConcurrentMap)
+func (m *ConcurrentMap[K, V]) CompareAndSwap(key K, old V, new V) bool {
+	s := m.shard(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	current, ok := s.entries[key]
+	if !ok || any(current) != any(old) {
+		return false
+	}
+	s.entries[key] = new
+	return true
+}
+
+// Atomically update the value for a key. The callback is given the current
+// value and whether the key was present, and returns the new value and
+// whether to keep it; returning false removes the key. Compute returns the
+// value that is now stored and whether the key is present. If a
+// ComputeIfAbsent call for the key is running, Compute waits for it first.
+//
+// The callback runs while the key's shard is locked, so it should be quick,
+// and it must not use the map or it may deadlock.
+//
+// Time Complexity: O(m) (where m = complexity of fn)
+// Space Complexity: O(1)
+// Allocations: Shard resize. 1 for composite keys (see ConcurrentMap)
+func (m *ConcurrentMap[K, V]) Compute(key K, fn func(old V, loaded bool) (V, bool)) (V, bool) {
+	s := m.shard(key)
+	s.mu.Lock()
+	for {
+		call, ok := s.pending[key]
+		if !ok {
+			break
+		}
+		s.mu.Unlock()
+		<-call.done
+		s.mu.Lock()
+	}
+	defer s.mu.Unlock()
+	old, loaded := s.entries[key]
+	value, keep := fn(old, loaded)
+	if !keep {
+		delete(s.entries, key)
+		return *new(V), false
+	}
+	s.entries[key] = value
+	return value, true
+}
+
+// Get the value stored for a key if it is present. Otherwise, call fn to
+// create the value, store it and return it. The bool is true if the value was
+// loaded and false if this call created it.
+//
+// The callback runs at most once at a time for each key. If other goroutines
+// ask for the same key while it is running, they wait for it and then return
+// the value it created. The callback runs without any lock held, so it may be
+// slow and may use the map, as long as it does not ask for the same key.
+// If the key is stored by some other means while the callback is running,
+// the stored value wins and the callback's result is thrown away. If the
+// callback panics, nothing is stored and a waiting caller takes over.
+//
+// Time Complexity: O(m) (where m = complexity of fn)
+// Space Complexity: O(1)
+// Allocations: 1 pending call and channel if the key is absent. Shard resize.
+// 1 for composite keys (see ConcurrentMap)
+func (m *ConcurrentMap[K, V]) ComputeIfAbsent(key K, fn func() V) (V, bool) {
+	s := m.shard(key)
+	for {
+		s.mu.Lock()
+		if value, ok := s.entries[key]; ok {
+			s.mu.Unlock()
+			return value, true
+		}
+		if call, ok := s.pending[key]; ok {
+			s.mu.Unlock()
+			// Check again once it is done, since the callback may have
+			// panicked or the value may already have been deleted.
+			<-call.done
+			continue
+		}
+		call := &pendingCompute{done: make(chan struct{})}
+		s.pending[key] = call
+		s.mu.Unlock()
+		return s.runCompute(key, call, fn)
+	}
+}
+
+func (s *concurrentMapShard[K, V]) runCompute(key K, call *pendingCompute, fn func() V) (value V, loaded bool) {
+	finished := false
+	defer func() {
+		s.mu.Lock()
+		delete(s.pending, key)
+		if finished {
+			if existing, ok := s.entries[key]; ok {
+				value, loaded = existing, true
+			} else {
+				s.entries[key] = value
+			}
+		}
+		s.mu.Unlock()
+		close(call.done)
+	}()
+	value = fn()
+	finished = true
+	return value, false
+}
+
+// Get the number of keys in the map. Other goroutines may change the map
+// while it is counted, so the result is only exact when nothing else is
+// writing.
+//
+// Time Complexity: O(s) (where s = number of shards)
+// Space Complexity: O(1)
+// Allocations: None
+func (m *ConcurrentMap[K, V]) Len() int {
+	n := 0
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.mu.RLock()
+		n += len(s.entries)
+		s.mu.RUnlock()
+	}
+	return n
+}
+
+// Call fn for each key and value in the map, stopping early if it returns
+// false. Each shard is copied under its lock before its entries are visited,
+// so fn may use the map, including deleting the key it was given. The
+// entries seen within one shard are consistent, but changes to other shards
+// made during the call may or may not be seen. Use Snapshot for a consistent
+// view of the whole map.
+//
+// Time Complexity: O(n * m) (where m = complexity of fn)
+// Space Complexity: O(n / s) (where s = number of shards)
+// Allocations: 1 entry slice per shard.
+func (m *ConcurrentMap[K, V]) Range(fn func(key K, value V) bool) {
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.mu.RLock()
+		entries := make([]Pair[K, V], 0, len(s.entries))
+		for k, v := range s.entries {
+			entries = append(entries, NewPair(k, v))
+		}
+		s.mu.RUnlock()
+		for _, entry := range entries {
+			if !fn(entry.First, entry.Second) {
+				return
+			}
+		}
+	}
+}
+
+// Copy the map into a plain map. Every shard is locked while copying, so the
+// result is the contents of the map at a single point in time.
+//
+// Time Complexity: O(n + s) (where s = number of shards)
+// Space Complexity: O(n)
+// Allocations: 1 map, n elements.
+func (m *ConcurrentMap[K, V]) Snapshot() map[K]V {
+	for i := range m.shards {
+		m.shards[i].mu.RLock()
+	}
+	n := 0
+	for i := range m.shards {
+		n += len(m.shards[i].entries)
+	}
+	result := make(map[K]V, n)
+	for i := range m.shards {
+		for k, v := range m.shards[i].entries {
+			result[k] = v
+		}
+		m.shards[i].mu.RUnlock()
+	}
+	return result
+}
+
+/*****************
+Key hashing for picking a shard.
+*****************/
+
+// newKeyHasher creates a hash function for K, such that keys that are equal
+// with == always have the same hash. Keys whose underlying type is a string,
+// bool, number, pointer or channel are hashed directly from their memory,
+// which never allocates. Any other comparable type, such as a struct, array
+// or interface, is hashed field by field with reflection.
+func newKeyHasher[K comparable](seed maphash.Seed) func(K) uint64 {
+	intSeed := maphash.String(seed, "")
+	hashInt := func(x uint64) uint64 {
+		return mix64(x ^ intSeed)
+	}
+	typ := reflect.TypeOf((*K)(nil)).Elem()
+	switch typ.Kind() {
+	case reflect.String:
+		return func(key K) uint64 {
+			return maphash.String(seed, *(*string)(unsafe.Pointer(&key)))
+		}
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		// Equal values of these kinds always have the same bits.
+		size := typ.Size()
+		return func(key K) uint64 {
+			return hashInt(loadBits(unsafe.Pointer(&key), size))
+		}
+	case reflect.Float32:
+		return func(key K) uint64 {
+			return hashInt(math.Float64bits(normalizeZero(float64(*(*float32)(unsafe.Pointer(&key))))))
+		}
+	case reflect.Float64:
+		return func(key K) uint64 {
+			return hashInt(math.Float64bits(normalizeZero(*(*float64)(unsafe.Pointer(&key)))))
+		}
+	}
+	return func(key K) uint64 {
+		var h maphash.Hash
+		h.SetSeed(seed)
+		hashValue(&h, reflect.ValueOf(&key).Elem())
+		return h.Sum64()
+	}
+}
+
+// loadBits reads a value of 1, 2, 4 or 8 bytes as a uint64.
+func loadBits(p unsafe.Pointer, size uintptr) uint64 {
+	switch size {
+	case 1:
+		return uint64(*(*uint8)(p))
+	case 2:
+		return uint64(*(*uint16)(p))
+	case 4:
+		return uint64(*(*uint32)(p))
+	default:
+		return *(*uint64)(p)
+	}
+}
+
+// normalizeZero turns -0 into +0, since -0 == +0 means they need the same
+// hash.
+func normalizeZero(f float64) float64 {
+	if f == 0 {
+		return 0
+	}
+	return f
+}
+
+// mix64 is the finalizer from SplitMix64, which spreads every input bit
+// across the whole output so that sequential integers land in different
+// shards.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+func hashValue(h *maphash.Hash, v reflect.Value) {
+	var buf [8]byte
+	writeUint64 := func(x uint64) {
+		binary.LittleEndian.PutUint64(buf[:], x)
+		h.Write(buf[:])
+	}
+	writeFloat := func(f float64) {
+		writeUint64(math.Float64bits(normalizeZero(f)))
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			h.WriteByte(1)
+		} else {
+			h.WriteByte(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint64(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeFloat(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		writeFloat(real(c))
+		writeFloat(imag(c))
+	case reflect.String:
+		h.WriteString(v.String())
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		writeUint64(uint64(v.Pointer()))
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			hashValue(h, v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			// == ignores blank fields, so the hash must too.
+			if v.Type().Field(i).Name == "_" {
+				continue
+			}
+			hashValue(h, v.Field(i))
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			h.WriteByte(0)
+			return
+		}
+		h.WriteString(v.Elem().Type().String())
+		hashValue(h, v.Elem())
+	default:
+		// The same panic a built-in map gives for an interface key holding
+		// a slice, map or func.
+		panic(fmt.Sprintf("collections: hash of unhashable type %v", v.Type()))
+	}
+}
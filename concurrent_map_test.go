@@ -0,0 +1,307 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections_test
+
+import (
+	"math"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/RageCage64/collections-go"
+	"github.com/RageCage64/go-assert"
+)
+
+func TestConcurrentMapBasics(t *testing.T) {
+	m := collections.NewConcurrentMap[string, int]()
+	_, ok := m.Load("a")
+	assert.Assert(t, !ok, "expected a to be absent")
+
+	m.Store("a", 1)
+	m.Store("b", 2)
+	v, ok := m.Load("a")
+	assert.Assert(t, ok, "expected a to be present")
+	assert.Equal(t, 1, v)
+	assert.Equal(t, 2, m.Len())
+
+	actual, loaded := m.LoadOrStore("a", 10)
+	assert.Assert(t, loaded, "expected a to be loaded")
+	assert.Equal(t, 1, actual)
+	actual, loaded = m.LoadOrStore("c", 3)
+	assert.Assert(t, !loaded, "expected c to be stored")
+	assert.Equal(t, 3, actual)
+
+	v, ok = m.LoadAndDelete("b")
+	assert.Assert(t, ok, "expected b to be deleted")
+	assert.Equal(t, 2, v)
+	_, ok = m.LoadAndDelete("b")
+	assert.Assert(t, !ok, "expected b to already be gone")
+
+	m.Delete("c")
+	assert.Equal(t, 1, m.Len())
+}
+
+func TestConcurrentMapCompareAndSwap(t *testing.T) {
+	m := collections.NewConcurrentMap[string, int]()
+	assert.Assert(t, !m.CompareAndSwap("a", 0, 1), "expected no swap of an absent key")
+	m.Store("a", 1)
+	assert.Assert(t, !m.CompareAndSwap("a", 2, 3), "expected no swap of a different value")
+	assert.Assert(t, m.CompareAndSwap("a", 1, 3), "expected a swap")
+	v, _ := m.Load("a")
+	assert.Equal(t, 3, v)
+}
+
+func TestConcurrentMapCompute(t *testing.T) {
+	m := collections.NewConcurrentMap[string, int]()
+	v, ok := m.Compute("a", func(old int, loaded bool) (int, bool) {
+		assert.Assert(t, !loaded, "expected a to be absent")
+		return 1, true
+	})
+	assert.Assert(t, ok, "expected a to be stored")
+	assert.Equal(t, 1, v)
+
+	_, ok = m.Compute("a", func(old int, loaded bool) (int, bool) {
+		return 0, false
+	})
+	assert.Assert(t, !ok, "expected a to be removed")
+	_, ok = m.Load("a")
+	assert.Assert(t, !ok, "expected a to be removed")
+}
+
+func TestConcurrentMapComputeContention(t *testing.T) {
+	m := collections.NewConcurrentMapWithShards[int, int](4)
+	const goroutines, increments = 16, 500
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < increments; i++ {
+				m.Compute(i%10, func(old int, _ bool) (int, bool) {
+					return old + 1, true
+				})
+			}
+		}()
+	}
+	wg.Wait()
+	for k := 0; k < 10; k++ {
+		v, _ := m.Load(k)
+		assert.Equal(t, goroutines*increments/10, v)
+	}
+}
+
+func TestConcurrentMapComputeIfAbsentRunsOnce(t *testing.T) {
+	m := collections.NewConcurrentMap[string, int]()
+	var calls int32
+	var wg sync.WaitGroup
+	results := make([]int, 50)
+	for g := range results {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			results[g], _ = m.ComputeIfAbsent("key", func() int {
+				atomic.AddInt32(&calls, 1)
+				time.Sleep(10 * time.Millisecond)
+				return 42
+			})
+		}(g)
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	for _, result := range results {
+		assert.Equal(t, 42, result)
+	}
+
+	v, loaded := m.ComputeIfAbsent("key", func() int {
+		t.Fatal("expected the callback not to run for a present key")
+		return 0
+	})
+	assert.Assert(t, loaded, "expected key to be loaded")
+	assert.Equal(t, 42, v)
+}
+
+func TestConcurrentMapComputeIfAbsentUsesMap(t *testing.T) {
+	m := collections.NewConcurrentMapWithShards[string, int](1)
+	m.Store("base", 5)
+	v, loaded := m.ComputeIfAbsent("derived", func() int {
+		base, _ := m.Load("base")
+		return base * 2
+	})
+	assert.Assert(t, !loaded, "expected derived to be created")
+	assert.Equal(t, 10, v)
+}
+
+func TestConcurrentMapComputeIfAbsentPanic(t *testing.T) {
+	m := collections.NewConcurrentMap[string, int]()
+	func() {
+		defer func() {
+			assert.Assert(t, recover() != nil, "expected the panic to be passed on")
+		}()
+		m.ComputeIfAbsent("a", func() int { panic("boom") })
+	}()
+	_, ok := m.Load("a")
+	assert.Assert(t, !ok, "expected nothing to be stored")
+
+	v, loaded := m.ComputeIfAbsent("a", func() int { return 1 })
+	assert.Assert(t, !loaded, "expected a to be created")
+	assert.Equal(t, 1, v)
+}
+
+func TestConcurrentMapRange(t *testing.T) {
+	m := collections.NewConcurrentMap[int, int]()
+	for i := 0; i < 100; i++ {
+		m.Store(i, i*i)
+	}
+	seen := collections.NewSet[int]()
+	m.Range(func(k int, v int) bool {
+		assert.Equal(t, k*k, v)
+		seen.Add(k)
+		m.Delete(k)
+		return true
+	})
+	assert.Equal(t, 100, len(seen))
+	assert.Equal(t, 0, m.Len())
+
+	m.Store(1, 1)
+	m.Store(2, 2)
+	visits := 0
+	m.Range(func(int, int) bool {
+		visits++
+		return false
+	})
+	assert.Equal(t, 1, visits)
+}
+
+func TestConcurrentMapSnapshot(t *testing.T) {
+	m := collections.NewConcurrentMap[string, int]()
+	m.Store("a", 1)
+	m.Store("b", 2)
+	snapshot := m.Snapshot()
+	m.Store("c", 3)
+	assert.Equal(t, 2, len(snapshot))
+	assert.Equal(t, 1, snapshot["a"])
+	assert.Equal(t, 2, snapshot["b"])
+}
+
+func TestConcurrentMapKeyHashing(t *testing.T) {
+	type point struct {
+		x, y float64
+		name string
+	}
+	m := collections.NewConcurrentMap[point, int]()
+	for i := 0; i < 100; i++ {
+		m.Store(point{float64(i), 0, "p"}, i)
+	}
+	v, ok := m.Load(point{7, 0, "p"})
+	assert.Assert(t, ok, "expected an equal struct key to be found")
+	assert.Equal(t, 7, v)
+	v, ok = m.Load(point{0, math.Copysign(0, -1), "p"})
+	assert.Assert(t, ok, "expected -0 to find the key stored with +0")
+	assert.Equal(t, 0, v)
+
+	// Blank fields are ignored by ==, so keys that differ only in a blank
+	// field must find each other. The only way to give a blank field a
+	// value is to write to its memory directly.
+	type padded struct {
+		id int
+		_  int
+	}
+	type raw struct {
+		id, blank int
+	}
+	padMap := collections.NewConcurrentMapWithShards[padded, int](64)
+	for i := 0; i < 200; i++ {
+		padMap.Store(padded{id: i}, i)
+	}
+	for i := 0; i < 200; i++ {
+		key := *(*padded)(unsafe.Pointer(&raw{id: i, blank: i + 1}))
+		assert.Assert(t, key == padded{id: i}, "expected keys differing in a blank field to be equal")
+		v, ok := padMap.Load(key)
+		assert.Assert(t, ok, "expected key %d with a different blank field to be found", i)
+		assert.Equal(t, i, v)
+	}
+
+	anyKeys := collections.NewConcurrentMap[any, string]()
+	anyKeys.Store(1, "int")
+	anyKeys.Store("1", "string")
+	anyKeys.Store(nil, "nil")
+	for key, expected := range map[any]string{1: "int", "1": "string", nil: "nil"} {
+		v, _ := anyKeys.Load(key)
+		assert.Equal(t, expected, v)
+	}
+}
+
+func TestConcurrentMapParallel(t *testing.T) {
+	m := collections.NewConcurrentMap[int, int]()
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				key := g*1000 + i
+				m.Store(key, i)
+				m.LoadOrStore(key, -1)
+				m.CompareAndSwap(key, i, i+1)
+				if i%2 == 0 {
+					m.Delete(key)
+				}
+				m.Snapshot()
+			}
+		}(g)
+	}
+	wg.Wait()
+	assert.Equal(t, 4000, m.Len())
+	for key, v := range m.Snapshot() {
+		assert.Equal(t, key%1000+1, v)
+	}
+}
+
+func TestConcurrentMapDoesNotAllocate(t *testing.T) {
+	type id int64
+	type flag bool
+	ids := collections.NewConcurrentMap[id, int64]()
+	small := collections.NewConcurrentMap[int16, int64]()
+	floats := collections.NewConcurrentMap[float32, int64]()
+	names := collections.NewConcurrentMap[string, int64]()
+	pointers := collections.NewConcurrentMap[*int, int64]()
+	flags := collections.NewConcurrentMap[flag, int64]()
+	target := new(int)
+	ids.Store(1<<40, 1<<40)
+	small.Store(-300, 1<<40)
+	floats.Store(1.5, 1<<40)
+	names.Store("name", 1<<40)
+	pointers.Store(target, 1<<40)
+	flags.Store(true, 1<<40)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		ids.Load(1 << 40)
+		ids.Store(1<<40, 1<<40)
+		ids.CompareAndSwap(1<<40, 1<<40, 1<<40)
+		small.Load(-300)
+		floats.LoadOrStore(1.5, 1<<40)
+		names.Load("name")
+		pointers.Load(target)
+		flags.Compute(true, func(old int64, _ bool) (int64, bool) { return old, true })
+	})
+	assert.Equal(t, 0.0, allocs)
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is the source of the current time for time-based collections such
+// as ExpiringSet. Supplying your own Clock allows tests to control time
+// directly instead of sleeping.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock is a Clock that reports the real current time.
+type SystemClock struct{}
+
+// Now returns time.Now().
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+type expiringEntry struct {
+	expires time.Time
+	ttl     time.Duration
+}
+
+// ExpiringSet is a set where each element is forgotten once its time to live
+// has passed. Expired elements are never reported as present, but they still
+// take up space until they are removed by Sweep, a background janitor (see
+// StartJanitor) or a lookup of that element. An ExpiringSet is safe for
+// concurrent use.
+type ExpiringSet[T comparable] struct {
+	mu         sync.Mutex
+	entries    map[T]expiringEntry
+	defaultTTL time.Duration
+	clock      Clock
+}
+
+// Initialize a new ExpiringSet where Add uses the given default time to live,
+// using the system clock.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: 1 map
+func NewExpiringSet[T comparable](defaultTTL time.Duration) *ExpiringSet[T] {
+	return NewExpiringSetWithClock[T](defaultTTL, SystemClock{})
+}
+
+// Initialize a new ExpiringSet where Add uses the given default time to live,
+// reading the current time from clock.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: 1 map
+func NewExpiringSetWithClock[T comparable](defaultTTL time.Duration, clock Clock) *ExpiringSet[T] {
+	return &ExpiringSet[T]{
+		entries:    map[T]expiringEntry{},
+		defaultTTL: defaultTTL,
+		clock:      clock,
+	}
+}
+
+// Add an element to the set with the default time to live. Adding an element
+// that is already present resets its expiry.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: Set resize
+func (s *ExpiringSet[T]) Add(el T) {
+	s.AddWithTTL(el, s.defaultTTL)
+}
+
+// Add an element to the set that expires once ttl has passed. A ttl that is
+// zero or negative means the element is expired immediately. Adding an
+// element that is already present replaces its expiry and time to live.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: Set resize
+func (s *ExpiringSet[T]) AddWithTTL(el T, ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[el] = expiringEntry{expires: s.clock.Now().Add(ttl), ttl: ttl}
+}
+
+// Check if the set contains a particular value that has not yet expired.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (s *ExpiringSet[T]) Contains(el T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.lookup(el, s.clock.Now())
+	return ok
+}
+
+// Refresh resets the expiry of an element as if it was just added with the
+// same time to live. Returns false if the element is not present or has
+// already expired, in which case nothing is changed.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (s *ExpiringSet[T]) Refresh(el T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.clock.Now()
+	entry, ok := s.lookup(el, now)
+	if !ok {
+		return false
+	}
+	entry.expires = now.Add(entry.ttl)
+	s.entries[el] = entry
+	return true
+}
+
+// Remove an element from the set.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (s *ExpiringSet[T]) Remove(el T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, el)
+}
+
+// Len returns the number of elements that have not yet expired.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+// Allocations: None
+func (s *ExpiringSet[T]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.clock.Now()
+	n := 0
+	for _, entry := range s.entries {
+		if now.Before(entry.expires) {
+			n++
+		}
+	}
+	return n
+}
+
+// Sweep removes every expired element from the set and returns how many
+// were removed.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+// Allocations: None
+func (s *ExpiringSet[T]) Sweep() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.clock.Now()
+	removed := 0
+	for el, entry := range s.entries {
+		if !now.Before(entry.expires) {
+			delete(s.entries, el)
+			removed++
+		}
+	}
+	return removed
+}
+
+// StartJanitor starts a goroutine that calls Sweep every interval. The
+// interval is measured in real time regardless of the set's Clock. Call the
+// returned function to stop the janitor; calling it more than once is safe.
+func (s *ExpiringSet[T]) StartJanitor(interval time.Duration) (stop func()) {
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				s.Sweep()
+			case <-done:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(done) })
+	}
+}
+
+// lookup must be called with the lock held. Expired elements found along the
+// way are removed.
+func (s *ExpiringSet[T]) lookup(el T, now time.Time) (expiringEntry, bool) {
+	entry, ok := s.entries[el]
+	if !ok {
+		return entry, false
+	}
+	if !now.Before(entry.expires) {
+		delete(s.entries, el)
+		return entry, false
+	}
+	return entry, true
+}
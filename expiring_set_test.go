@@ -0,0 +1,128 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/RageCage64/collections-go"
+	"github.com/RageCage64/go-assert"
+)
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestExpiringSet(t *testing.T) {
+	clock := newFakeClock()
+	set := collections.NewExpiringSetWithClock[string](10*time.Minute, clock)
+	set.Add("a")
+	set.AddWithTTL("b", time.Minute)
+	assert.Equal(t, 2, set.Len())
+
+	clock.Advance(time.Minute)
+	assert.Assert(t, set.Contains("a"), "expected a to be present")
+	assert.Assert(t, !set.Contains("b"), "expected b to have expired")
+	assert.Equal(t, 1, set.Len())
+
+	clock.Advance(9 * time.Minute)
+	assert.Assert(t, !set.Contains("a"), "expected a to have expired")
+	assert.Equal(t, 0, set.Len())
+}
+
+func TestExpiringSetRefresh(t *testing.T) {
+	clock := newFakeClock()
+	set := collections.NewExpiringSetWithClock[int](time.Minute, clock)
+	set.Add(1)
+
+	clock.Advance(50 * time.Second)
+	assert.Assert(t, set.Refresh(1), "expected refresh to succeed")
+	clock.Advance(50 * time.Second)
+	assert.Assert(t, set.Contains(1), "expected refreshed element to be present")
+
+	clock.Advance(10 * time.Second)
+	assert.Assert(t, !set.Refresh(1), "expected refresh of expired element to fail")
+	assert.Assert(t, !set.Refresh(2), "expected refresh of missing element to fail")
+}
+
+func TestExpiringSetSweep(t *testing.T) {
+	clock := newFakeClock()
+	set := collections.NewExpiringSetWithClock[int](time.Minute, clock)
+	set.Add(1)
+	set.Add(2)
+	set.AddWithTTL(3, time.Hour)
+
+	clock.Advance(time.Minute)
+	assert.Equal(t, 2, set.Sweep())
+	assert.Equal(t, 0, set.Sweep())
+	assert.Equal(t, 1, set.Len())
+}
+
+// notifyingClock signals on calls every time the time is read, so a test can
+// tell when the janitor has run without sleeping.
+type notifyingClock struct {
+	*fakeClock
+	calls chan struct{}
+}
+
+func (c notifyingClock) Now() time.Time {
+	select {
+	case c.calls <- struct{}{}:
+	default:
+	}
+	return c.fakeClock.Now()
+}
+
+func TestExpiringSetJanitor(t *testing.T) {
+	clock := notifyingClock{fakeClock: newFakeClock(), calls: make(chan struct{}, 1)}
+	set := collections.NewExpiringSetWithClock[int](time.Minute, clock)
+	set.Add(1)
+	<-clock.calls
+	clock.Advance(time.Minute)
+
+	stop := set.StartJanitor(time.Millisecond)
+	select {
+	case <-clock.calls:
+	case <-time.After(5 * time.Second):
+		t.Fatal("janitor did not run")
+	}
+	stop()
+	stop()
+	assert.Equal(t, 0, set.Sweep())
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections
+
+import "fmt"
+
+// CheckInvariants reports the first way the tree breaks the rules of a
+// size-augmented AVL tree, if any.
+func (t *TreeMap[K, V]) CheckInvariants() error {
+	_, err := t.check(t.root, nil, nil)
+	return err
+}
+
+func (t *TreeMap[K, V]) check(n *treeNode[K, V], lo *K, hi *K) (int, error) {
+	if n == nil {
+		return 0, nil
+	}
+	if lo != nil && t.cmp(n.key, *lo) <= 0 {
+		return 0, fmt.Errorf("key %v is not greater than %v", n.key, *lo)
+	}
+	if hi != nil && t.cmp(n.key, *hi) >= 0 {
+		return 0, fmt.Errorf("key %v is not less than %v", n.key, *hi)
+	}
+	lh, err := t.check(n.left, lo, &n.key)
+	if err != nil {
+		return 0, err
+	}
+	rh, err := t.check(n.right, &n.key, hi)
+	if err != nil {
+		return 0, err
+	}
+	if lh-rh > 1 || rh-lh > 1 {
+		return 0, fmt.Errorf("node %v is unbalanced: %d vs %d", n.key, lh, rh)
+	}
+	height := lh + 1
+	if rh >= lh {
+		height = rh + 1
+	}
+	if n.height != height {
+		return 0, fmt.Errorf("node %v has height %d, expected %d", n.key, n.height, height)
+	}
+	if size := n.left.sizeOf() + n.right.sizeOf() + 1; n.size != size {
+		return 0, fmt.Errorf("node %v has size %d, expected %d", n.key, n.size, size)
+	}
+	return height, nil
+}
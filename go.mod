@@ -1,5 +1,5 @@
 module github.com/RageCage64/collections-go
 
-go 1.18
+go 1.20
 
 require github.com/RageCage64/go-assert v0.2.2
@@ -0,0 +1,346 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Interval is the half-open range of values [Lo, Hi). An Interval where Hi
+// is not greater than Lo is empty.
+type Interval[T Ordered] struct {
+	Lo T
+	Hi T
+}
+
+// Check if the interval contains no values.
+func (iv Interval[T]) Empty() bool {
+	return !(iv.Lo < iv.Hi)
+}
+
+// Check if the interval contains a particular value.
+func (iv Interval[T]) Contains(v T) bool {
+	return iv.Lo <= v && v < iv.Hi
+}
+
+// String formats the interval as [Lo, Hi).
+func (iv Interval[T]) String() string {
+	return fmt.Sprintf("[%v, %v)", iv.Lo, iv.Hi)
+}
+
+// IntervalSet is a set of values stored as sorted, disjoint, half-open
+// intervals. Intervals that overlap or touch are merged as they are added,
+// so a contiguous run of values always takes up a single interval no matter
+// how many values it contains. The zero value is an empty IntervalSet ready
+// to use.
+type IntervalSet[T Ordered] struct {
+	intervals []Interval[T]
+}
+
+// Initialize a new IntervalSet containing each of the given intervals.
+//
+// Time Complexity: O(n log n)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements (variadic function argument). 1 slice of
+// at most n intervals, resized as necessary.
+func NewIntervalSet[T Ordered](intervals ...Interval[T]) *IntervalSet[T] {
+	set := &IntervalSet[T]{}
+	for i := 0; i < len(intervals); i++ {
+		set.AddRange(intervals[i].Lo, intervals[i].Hi)
+	}
+	return set
+}
+
+// Initialize a new IntervalSet with every value in a Set. Runs of
+// consecutive integers become a single interval. Since intervals are
+// half-open, the maximum value of T can't be stored, so this panics if the
+// Set contains it.
+//
+// Time Complexity: O(n log n)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements. 1 slice of at most n intervals.
+func IntervalSetFromSet[T Integer](set Set[T]) *IntervalSet[T] {
+	values := set.ToSlice()
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	// Only the largest value can be the maximum of T, which is the only
+	// value that wraps around when 1 is added.
+	if n := len(values); n > 0 && values[n-1]+1 < values[n-1] {
+		panic(fmt.Sprintf("collections: IntervalSet can't hold %v, the maximum value of its type", values[n-1]))
+	}
+	result := &IntervalSet[T]{}
+	for i := 0; i < len(values); i++ {
+		last := len(result.intervals) - 1
+		if last >= 0 && result.intervals[last].Hi == values[i] {
+			result.intervals[last].Hi++
+		} else {
+			result.intervals = append(result.intervals, Interval[T]{values[i], values[i] + 1})
+		}
+	}
+	return result
+}
+
+// IntervalSetToSet creates a Set holding every value in the IntervalSet.
+// This is only sensible for small domains, since every value is stored
+// individually.
+//
+// Time Complexity: O(m) (where m is the number of values covered)
+// Space Complexity: O(m)
+// Allocations: 1 set, m elements
+func IntervalSetToSet[T Integer](set *IntervalSet[T]) Set[T] {
+	// Measure each interval as uint64, which can hold the distance between
+	// any two values of any integer type, and stop counting once the total
+	// is too large to be a size hint.
+	var n uint64
+	for _, iv := range set.intervals {
+		n += uint64(iv.Hi) - uint64(iv.Lo)
+		if n > math.MaxInt32 {
+			n = 0
+			break
+		}
+	}
+	result := make(Set[T], int(n))
+	for _, iv := range set.intervals {
+		for v := iv.Lo; v < iv.Hi; v++ {
+			result.Add(v)
+		}
+	}
+	return result
+}
+
+// Intervals returns a copy of the disjoint intervals in ascending order.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements
+func (s *IntervalSet[T]) Intervals() []Interval[T] {
+	result := make([]Interval[T], len(s.intervals))
+	copy(result, s.intervals)
+	return result
+}
+
+// Len returns the number of disjoint intervals in the set.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (s *IntervalSet[T]) Len() int {
+	return len(s.intervals)
+}
+
+// AddRange adds every value in [lo, hi) to the set. Empty ranges are
+// ignored.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+// Allocations: Slice resize
+func (s *IntervalSet[T]) AddRange(lo T, hi T) {
+	if !(lo < hi) {
+		return
+	}
+	// i is the first interval that overlaps or touches the new range from the
+	// left, j is the first interval entirely to the right of it.
+	i := sort.Search(len(s.intervals), func(k int) bool { return s.intervals[k].Hi >= lo })
+	j := sort.Search(len(s.intervals), func(k int) bool { return s.intervals[k].Lo > hi })
+	if i < j {
+		if s.intervals[i].Lo < lo {
+			lo = s.intervals[i].Lo
+		}
+		if s.intervals[j-1].Hi > hi {
+			hi = s.intervals[j-1].Hi
+		}
+	}
+	s.replace(i, j, Interval[T]{lo, hi})
+}
+
+// RemoveRange removes every value in [lo, hi) from the set. Empty ranges are
+// ignored.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+// Allocations: Slice resize
+func (s *IntervalSet[T]) RemoveRange(lo T, hi T) {
+	if !(lo < hi) {
+		return
+	}
+	i := sort.Search(len(s.intervals), func(k int) bool { return s.intervals[k].Hi > lo })
+	j := sort.Search(len(s.intervals), func(k int) bool { return s.intervals[k].Lo >= hi })
+	if i >= j {
+		return
+	}
+	left := Interval[T]{s.intervals[i].Lo, lo}
+	right := Interval[T]{hi, s.intervals[j-1].Hi}
+	switch {
+	case !left.Empty() && !right.Empty():
+		s.replace(i, j, left, right)
+	case !left.Empty():
+		s.replace(i, j, left)
+	case !right.Empty():
+		s.replace(i, j, right)
+	default:
+		s.replace(i, j)
+	}
+}
+
+// replace swaps the intervals in [i, j) for the given intervals in place.
+func (s *IntervalSet[T]) replace(i int, j int, with ...Interval[T]) {
+	tail := len(s.intervals) - j
+	newLen := i + len(with) + tail
+	if newLen > cap(s.intervals) {
+		grown := make([]Interval[T], newLen, newLen*2)
+		copy(grown, s.intervals[:i])
+		copy(grown[i+len(with):], s.intervals[j:])
+		s.intervals = grown
+	} else {
+		old := s.intervals
+		s.intervals = s.intervals[:newLen]
+		copy(s.intervals[i+len(with):], old[j:])
+	}
+	copy(s.intervals[i:], with)
+}
+
+// Check if the set contains a particular value.
+//
+// Time Complexity: O(log n)
+// Space Complexity: O(1)
+// Allocations: None
+func (s *IntervalSet[T]) Contains(v T) bool {
+	i := sort.Search(len(s.intervals), func(k int) bool { return s.intervals[k].Hi > v })
+	return i < len(s.intervals) && s.intervals[i].Lo <= v
+}
+
+// Check if any value in [lo, hi) is in the set.
+//
+// Time Complexity: O(log n)
+// Space Complexity: O(1)
+// Allocations: None
+func (s *IntervalSet[T]) Overlaps(lo T, hi T) bool {
+	if !(lo < hi) {
+		return false
+	}
+	i := sort.Search(len(s.intervals), func(k int) bool { return s.intervals[k].Hi > lo })
+	return i < len(s.intervals) && s.intervals[i].Lo < hi
+}
+
+// Gaps returns the parts of [lo, hi) that are not in the set, in ascending
+// order.
+//
+// Time Complexity: O(log n + k) (where k is the number of intervals in range)
+// Space Complexity: O(k)
+// Allocations: 1 slice, resized as necessary
+func (s *IntervalSet[T]) Gaps(lo T, hi T) []Interval[T] {
+	var gaps []Interval[T]
+	if !(lo < hi) {
+		return gaps
+	}
+	i := sort.Search(len(s.intervals), func(k int) bool { return s.intervals[k].Hi > lo })
+	curr := lo
+	for ; i < len(s.intervals) && s.intervals[i].Lo < hi; i++ {
+		if curr < s.intervals[i].Lo {
+			gaps = append(gaps, Interval[T]{curr, s.intervals[i].Lo})
+		}
+		curr = s.intervals[i].Hi
+	}
+	if curr < hi {
+		gaps = append(gaps, Interval[T]{curr, hi})
+	}
+	return gaps
+}
+
+// Complement returns a new IntervalSet holding every value in [lo, hi) that
+// is not in the set.
+//
+// Time Complexity: O(log n + k) (where k is the number of intervals in range)
+// Space Complexity: O(k)
+// Allocations: 1 slice, resized as necessary
+func (s *IntervalSet[T]) Complement(lo T, hi T) *IntervalSet[T] {
+	return &IntervalSet[T]{intervals: s.Gaps(lo, hi)}
+}
+
+// Union returns a new IntervalSet holding every value in either set.
+//
+// Time Complexity: O(n + m)
+// Space Complexity: O(n + m)
+// Allocations: 1 slice, n + m elements
+func (s *IntervalSet[T]) Union(other *IntervalSet[T]) *IntervalSet[T] {
+	a, b := s.intervals, other.intervals
+	result := make([]Interval[T], 0, len(a)+len(b))
+	for len(a) > 0 || len(b) > 0 {
+		var next Interval[T]
+		if len(b) == 0 || (len(a) > 0 && a[0].Lo < b[0].Lo) {
+			next, a = a[0], a[1:]
+		} else {
+			next, b = b[0], b[1:]
+		}
+		last := len(result) - 1
+		if last >= 0 && next.Lo <= result[last].Hi {
+			if next.Hi > result[last].Hi {
+				result[last].Hi = next.Hi
+			}
+		} else {
+			result = append(result, next)
+		}
+	}
+	return &IntervalSet[T]{intervals: result}
+}
+
+// Intersection returns a new IntervalSet holding every value in both sets.
+//
+// Time Complexity: O(n + m)
+// Space Complexity: O(n + m)
+// Allocations: 1 slice, resized as necessary
+func (s *IntervalSet[T]) Intersection(other *IntervalSet[T]) *IntervalSet[T] {
+	a, b := s.intervals, other.intervals
+	var result []Interval[T]
+	for len(a) > 0 && len(b) > 0 {
+		lo, hi := a[0].Lo, a[0].Hi
+		if b[0].Lo > lo {
+			lo = b[0].Lo
+		}
+		if b[0].Hi < hi {
+			hi = b[0].Hi
+		}
+		if lo < hi {
+			result = append(result, Interval[T]{lo, hi})
+		}
+		if a[0].Hi < b[0].Hi {
+			a = a[1:]
+		} else {
+			b = b[1:]
+		}
+	}
+	return &IntervalSet[T]{intervals: result}
+}
+
+// String formats the set as a list of its intervals.
+func (s *IntervalSet[T]) String() string {
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i, iv := range s.intervals {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(iv.String())
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/RageCage64/collections-go"
+	"github.com/RageCage64/go-assert"
+)
+
+type interval = collections.Interval[int]
+
+func TestIntervalSetAddRangeMerges(t *testing.T) {
+	set := &collections.IntervalSet[int]{}
+	set.AddRange(1, 3)
+	set.AddRange(5, 7)
+	set.AddRange(3, 5)
+	set.AddRange(10, 12)
+	set.AddRange(0, 0)
+	assert.SliceEqual(t, []interval{{1, 7}, {10, 12}}, set.Intervals())
+	assert.Equal(t, "{[1, 7), [10, 12)}", set.String())
+}
+
+func TestIntervalSetRemoveRange(t *testing.T) {
+	set := collections.NewIntervalSet(interval{0, 10}, interval{20, 30})
+	set.RemoveRange(5, 25)
+	assert.SliceEqual(t, []interval{{0, 5}, {25, 30}}, set.Intervals())
+	set.RemoveRange(1, 2)
+	assert.SliceEqual(t, []interval{{0, 1}, {2, 5}, {25, 30}}, set.Intervals())
+	set.RemoveRange(-5, 50)
+	assert.Equal(t, 0, set.Len())
+}
+
+func TestIntervalSetQueries(t *testing.T) {
+	set := collections.NewIntervalSet(interval{0, 10}, interval{20, 30})
+	assert.Assert(t, set.Contains(0), "expected 0 to be contained")
+	assert.Assert(t, !set.Contains(10), "expected 10 not to be contained")
+	assert.Assert(t, set.Overlaps(9, 20), "expected [9, 20) to overlap")
+	assert.Assert(t, !set.Overlaps(10, 20), "expected [10, 20) not to overlap")
+	assert.SliceEqual(t, []interval{{-5, 0}, {10, 20}, {30, 35}}, set.Gaps(-5, 35))
+	assert.SliceEqual(t, []interval{{10, 20}}, set.Complement(5, 25).Intervals())
+}
+
+func TestIntervalSetUnionIntersection(t *testing.T) {
+	a := collections.NewIntervalSet(interval{0, 5}, interval{10, 15})
+	b := collections.NewIntervalSet(interval{5, 8}, interval{12, 20})
+	assert.SliceEqual(t, []interval{{0, 8}, {10, 20}}, a.Union(b).Intervals())
+	assert.SliceEqual(t, []interval{{12, 15}}, a.Intersection(b).Intervals())
+}
+
+func TestIntervalSetStrings(t *testing.T) {
+	set := collections.NewIntervalSet(collections.Interval[string]{"a", "c"})
+	assert.Assert(t, set.Contains("b"), "expected b to be contained")
+	assert.Assert(t, set.Contains("bzzz"), "expected bzzz to be contained")
+	assert.Assert(t, !set.Contains("c"), "expected c not to be contained")
+}
+
+func TestIntervalSetSetConversion(t *testing.T) {
+	set := collections.IntervalSetFromSet(collections.NewSet(1, 2, 3, 5, 7, 8))
+	assert.SliceEqual(t, []interval{{1, 4}, {5, 6}, {7, 9}}, set.Intervals())
+	compareSetAndSlice(t, collections.IntervalSetToSet(set), []int{1, 2, 3, 5, 7, 8})
+}
+
+func TestIntervalSetFromSetMaxValuePanics(t *testing.T) {
+	defer func() {
+		assert.Assert(t, recover() != nil, "expected the maximum value of uint8 to panic")
+	}()
+	collections.IntervalSetFromSet(collections.NewSet[uint8](253, 254, 255))
+}
+
+func TestIntervalSetSmallTypeBounds(t *testing.T) {
+	set := collections.IntervalSetFromSet(collections.NewSet[int8](-128, -127, 125, 126))
+	assert.SliceEqual(
+		t,
+		[]collections.Interval[int8]{{Lo: -128, Hi: -126}, {Lo: 125, Hi: 127}},
+		set.Intervals(),
+	)
+	assert.Assert(t, set.Contains(126), "expected the set to contain 126")
+
+	// The width of this interval doesn't fit in an int8.
+	full := collections.NewIntervalSet(collections.Interval[int8]{Lo: -128, Hi: 127})
+	values := collections.IntervalSetToSet(full)
+	assert.Equal(t, 255, len(values))
+	assert.Assert(t, values.Contains(-128) && values.Contains(126), "expected both ends of the interval")
+}
+
+// Compare the IntervalSet against a plain Set over a small domain after every
+// one of a series of random operations.
+func TestIntervalSetRandomized(t *testing.T) {
+	const domain = 64
+	rng := rand.New(rand.NewSource(1))
+	set := &collections.IntervalSet[int]{}
+	model := collections.Set[int]{}
+	for step := 0; step < 2000; step++ {
+		lo := rng.Intn(domain)
+		hi := lo + rng.Intn(12)
+		if rng.Intn(2) == 0 {
+			set.AddRange(lo, hi)
+			for v := lo; v < hi; v++ {
+				model.Add(v)
+			}
+		} else {
+			set.RemoveRange(lo, hi)
+			for v := lo; v < hi; v++ {
+				model.Remove(v)
+			}
+		}
+
+		intervals := set.Intervals()
+		for i := 1; i < len(intervals); i++ {
+			if intervals[i-1].Hi >= intervals[i].Lo {
+				t.Fatalf("step %d: intervals not disjoint and merged: %v", step, set)
+			}
+		}
+		for v := -1; v <= domain+12; v++ {
+			if set.Contains(v) != model.Contains(v) {
+				t.Fatalf("step %d: Contains(%d) mismatch in %v", step, v, set)
+			}
+		}
+	}
+}
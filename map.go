@@ -0,0 +1,205 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections
+
+import (
+	"errors"
+	"fmt"
+)
+
+/*****************
+The direct API for plain Go maps.
+*****************/
+
+// Create a new slice with every key of the map, in no particular order.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements.
+func MapKeys[K comparable, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Create a new slice with every value of the map, in no particular order.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements.
+func MapValues[K comparable, V any](m map[K]V) []V {
+	values := make([]V, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+	return values
+}
+
+// Create a new slice with every key of the map in ascending order.
+//
+// Time Complexity: O(n * log(n))
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements.
+func MapKeysSorted[K Ordered, V any](m map[K]V) []K {
+	keys := MapKeys(m)
+	SliceSort(keys)
+	return keys
+}
+
+// Create a new slice with every value of the map, in ascending order of
+// their keys.
+//
+// Time Complexity: O(n * log(n))
+// Space Complexity: O(n)
+// Allocations: 2 slices, n elements.
+func MapValuesSorted[K Ordered, V any](m map[K]V) []V {
+	keys := MapKeysSorted(m)
+	values := make([]V, len(keys))
+	for i := 0; i < len(keys); i++ {
+		values[i] = m[keys[i]]
+	}
+	return values
+}
+
+// Create a new Set with every key of the map.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 1 set, n elements.
+func MapKeysSet[K comparable, V any](m map[K]V) Set[K] {
+	set := make(Set[K], len(m))
+	for k := range m {
+		set.Add(k)
+	}
+	return set
+}
+
+var ErrDuplicateValue = errors.New("collections: duplicate value")
+
+// Create a new map from each value of the map to its key. If two keys share
+// a value the map can't be inverted, so a nil map and an error wrapping
+// ErrDuplicateValue are returned.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 1 map, n elements.
+func MapInvert[K comparable, V comparable](m map[K]V) (map[V]K, error) {
+	inverted := make(map[V]K, len(m))
+	for k, v := range m {
+		if existing, ok := inverted[v]; ok {
+			return nil, fmt.Errorf("%w: keys %v and %v both have value %v", ErrDuplicateValue, existing, k, v)
+		}
+		inverted[v] = k
+	}
+	return inverted, nil
+}
+
+// Copy every entry of each source map into dst, in order, and return dst. If
+// dst is nil a new map is created. When a key is already in dst, resolve is
+// called with the key, the value already there and the incoming value, and
+// its result is stored. A nil resolve keeps the incoming value, so later
+// sources win.
+//
+// Time Complexity: O(n * m) (where n = total entries in srcs, m = complexity of resolve)
+// Space Complexity: O(n)
+// Allocations: Map resize, or 1 map if dst is nil.
+func MapMerge[K comparable, V any](
+	dst map[K]V,
+	resolve func(key K, existing V, incoming V) V,
+	srcs ...map[K]V,
+) map[K]V {
+	if dst == nil {
+		dst = map[K]V{}
+	}
+	for _, src := range srcs {
+		for k, v := range src {
+			if existing, ok := dst[k]; ok && resolve != nil {
+				v = resolve(k, existing, v)
+			}
+			dst[k] = v
+		}
+	}
+	return dst
+}
+
+// Create a new map with every entry for which the predicate was true.
+//
+// Time Complexity: O(n * m) (where m = complexity of pred)
+// Space Complexity: O(n)
+// Allocations: 1 map, resized as necessary.
+func MapFilter[K comparable, V any](m map[K]V, pred func(key K, value V) bool) map[K]V {
+	result := map[K]V{}
+	for k, v := range m {
+		if pred(k, v) {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// Create a new map with the same keys, where each value is the result of
+// running the mapper on the original value.
+//
+// Time Complexity: O(n * m) (where m = complexity of mapper)
+// Space Complexity: O(n)
+// Allocations: 1 map, n elements.
+func MapMapValues[K comparable, V any, W any](m map[K]V, mapper Mapper[V, W]) map[K]W {
+	result := make(map[K]W, len(m))
+	for k, v := range m {
+		result[k] = mapper(v)
+	}
+	return result
+}
+
+// Check if two maps have the same keys, with the values for each key equal
+// according to an equality function. The maps may have different value
+// types.
+//
+// Time Complexity: O(n * m) (where m = complexity of eq)
+// Space Complexity: O(1)
+// Allocations: None
+func MapEqualFunc[K comparable, V1 any, V2 any](a map[K]V1, b map[K]V2, eq func(V1, V2) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v1 := range a {
+		v2, ok := b[k]
+		if !ok || !eq(v1, v2) {
+			return false
+		}
+	}
+	return true
+}
+
+// Create a new slice with a Pair for each entry of the map, holding the key
+// and value, in no particular order. To sort the entries, use SliceSortBy.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements.
+func MapToSlice[K comparable, V any](m map[K]V) []Pair[K, V] {
+	entries := make([]Pair[K, V], 0, len(m))
+	for k, v := range m {
+		entries = append(entries, Pair[K, V]{First: k, Second: v})
+	}
+	return entries
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections_test
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/RageCage64/collections-go"
+	"github.com/RageCage64/go-assert"
+)
+
+func TestMapKeysValues(t *testing.T) {
+	m := map[string]int{"b": 2, "c": 3, "a": 1}
+
+	keys := collections.MapKeys(m)
+	sort.Strings(keys)
+	assert.SliceEqual(t, []string{"a", "b", "c"}, keys)
+
+	values := collections.MapValues(m)
+	sort.Ints(values)
+	assert.SliceEqual(t, []int{1, 2, 3}, values)
+
+	assert.SliceEqual(t, []string{"a", "b", "c"}, collections.MapKeysSorted(m))
+	assert.SliceEqual(t, []int{1, 2, 3}, collections.MapValuesSorted(m))
+
+	set := collections.MapKeysSet(m)
+	assert.Equal(t, 3, len(set))
+	assert.Assert(t, set.Equals(collections.NewSet("a", "b", "c")), "expected set to hold every key, got %v", set)
+}
+
+func TestMapInvert(t *testing.T) {
+	inverted, err := collections.MapInvert(map[string]int{"a": 1, "b": 2})
+	assert.NilErr(t, err)
+	assert.Equal(t, 2, len(inverted))
+	assert.Equal(t, "a", inverted[1])
+	assert.Equal(t, "b", inverted[2])
+
+	inverted, err = collections.MapInvert(map[string]int{"a": 1, "b": 1})
+	assert.Assert(t, errors.Is(err, collections.ErrDuplicateValue), "expected ErrDuplicateValue, got %v", err)
+	assert.Assert(t, inverted == nil, "expected a nil map on collision")
+}
+
+func TestMapMerge(t *testing.T) {
+	dst := map[string]int{"a": 1, "b": 2}
+	result := collections.MapMerge(dst, nil, map[string]int{"b": 20, "c": 30}, map[string]int{"c": 300})
+	assert.Equal(t, 3, len(dst))
+	assert.Equal(t, 1, dst["a"])
+	assert.Equal(t, 20, dst["b"])
+	assert.Equal(t, 300, dst["c"])
+	assert.Equal(t, 3, len(result))
+
+	sum := func(_ string, existing int, incoming int) int { return existing + incoming }
+	merged := collections.MapMerge(nil, sum, map[string]int{"a": 1}, map[string]int{"a": 2, "b": 3})
+	assert.Equal(t, 3, merged["a"])
+	assert.Equal(t, 3, merged["b"])
+}
+
+func TestMapFilter(t *testing.T) {
+	result := collections.MapFilter(map[string]int{"a": 1, "b": 2, "c": 3}, func(k string, v int) bool {
+		return k != "a" && v%2 == 1
+	})
+	assert.Equal(t, 1, len(result))
+	assert.Equal(t, 3, result["c"])
+}
+
+func TestMapMapValues(t *testing.T) {
+	result := collections.MapMapValues(map[int]string{1: "a", 2: "b"}, strings.ToUpper)
+	assert.Equal(t, 2, len(result))
+	assert.Equal(t, "A", result[1])
+	assert.Equal(t, "B", result[2])
+}
+
+func TestMapEqualFunc(t *testing.T) {
+	a := map[string][]int{"x": {1, 2}, "y": nil}
+	b := map[string]int{"x": 2, "y": 0}
+	sameLen := func(sl []int, n int) bool { return len(sl) == n }
+	assert.Assert(t, collections.MapEqualFunc(a, b, sameLen), "expected maps to be equal")
+
+	b["y"] = 1
+	assert.Assert(t, !collections.MapEqualFunc(a, b, sameLen), "expected different values not to be equal")
+	delete(b, "y")
+	assert.Assert(t, !collections.MapEqualFunc(a, b, sameLen), "expected different lengths not to be equal")
+	b["z"] = 0
+	assert.Assert(t, !collections.MapEqualFunc(a, b, sameLen), "expected different keys not to be equal")
+}
+
+func TestMapToSlice(t *testing.T) {
+	entries := collections.MapToSlice(map[string]int{"b": 2, "a": 1})
+	collections.SliceSortBy(entries, func(p collections.Pair[string, int]) string { return p.First })
+	assert.SliceEqual(t, []collections.Pair[string, int]{{"a", 1}, {"b", 2}}, entries)
+}
@@ -0,0 +1,337 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections
+
+// SetMultiMap is a map from each key to a Set of values. Putting the same
+// entry twice has no effect. A key is removed as soon as its last value is,
+// so KeyCount only counts keys that have values.
+//
+// A SetMultiMap is not safe for concurrent use.
+type SetMultiMap[K comparable, V comparable] struct {
+	buckets map[K]Set[V]
+	entries int
+}
+
+// Initialize a new, empty SetMultiMap.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: 1 map
+func NewSetMultiMap[K comparable, V comparable]() *SetMultiMap[K, V] {
+	return &SetMultiMap[K, V]{buckets: map[K]Set[V]{}}
+}
+
+// Add a value under a key. Returns false if the entry was already present.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: 1 set for a new key. Set resize.
+func (m *SetMultiMap[K, V]) Put(key K, value V) bool {
+	bucket, ok := m.buckets[key]
+	if !ok {
+		bucket = Set[V]{}
+		m.buckets[key] = bucket
+	} else if bucket.Contains(value) {
+		return false
+	}
+	bucket.Add(value)
+	m.entries++
+	return true
+}
+
+// Add each value under a key.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements (variadic function argument). 1 set for a
+// new key. Set resize.
+func (m *SetMultiMap[K, V]) PutAll(key K, values ...V) {
+	for i := 0; i < len(values); i++ {
+		m.Put(key, values[i])
+	}
+}
+
+// Get a copy of the values under a key. The Set is empty if the key is not
+// present.
+//
+// Time Complexity: O(n) (where n = values under key)
+// Space Complexity: O(n)
+// Allocations: 1 set, n elements.
+func (m *SetMultiMap[K, V]) Get(key K) Set[V] {
+	if bucket, ok := m.buckets[key]; ok {
+		return bucket.Clone()
+	}
+	return Set[V]{}
+}
+
+// Remove a value from under a key. Returns false if the entry was not
+// present.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (m *SetMultiMap[K, V]) Remove(key K, value V) bool {
+	bucket, ok := m.buckets[key]
+	if !ok || !bucket.Contains(value) {
+		return false
+	}
+	bucket.Remove(value)
+	m.entries--
+	if len(bucket) == 0 {
+		delete(m.buckets, key)
+	}
+	return true
+}
+
+// Remove a key and every value under it, returning the values. The Set is
+// empty if the key was not present.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (m *SetMultiMap[K, V]) RemoveAll(key K) Set[V] {
+	bucket, ok := m.buckets[key]
+	if !ok {
+		return Set[V]{}
+	}
+	delete(m.buckets, key)
+	m.entries -= len(bucket)
+	return bucket
+}
+
+// Check if a key has any values.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (m *SetMultiMap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.buckets[key]
+	return ok
+}
+
+// Check if a value is present under a key.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (m *SetMultiMap[K, V]) ContainsEntry(key K, value V) bool {
+	return m.buckets[key].Contains(value)
+}
+
+// Get the number of keys that have at least one value.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (m *SetMultiMap[K, V]) KeyCount() int {
+	return len(m.buckets)
+}
+
+// Get the total number of entries across every key.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (m *SetMultiMap[K, V]) EntryCount() int {
+	return m.entries
+}
+
+// Create a new slice with every key, in no particular order.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements.
+func (m *SetMultiMap[K, V]) Keys() []K {
+	return MapKeys(m.buckets)
+}
+
+// Create a new SetMultiMap with every entry reversed, so each value maps to
+// the keys it was stored under. Unlike BiMap.Inverse, the result is a copy.
+//
+// Time Complexity: O(n) (where n = number of entries)
+// Space Complexity: O(n)
+// Allocations: 1 map. 1 set per distinct value.
+func (m *SetMultiMap[K, V]) Inverse() *SetMultiMap[V, K] {
+	inverse := NewSetMultiMap[V, K]()
+	for k, bucket := range m.buckets {
+		for v := range bucket {
+			inverse.Put(v, k)
+		}
+	}
+	return inverse
+}
+
+// ListMultiMap is a map from each key to a slice of values, kept in the
+// order they were put. The same entry can be put more than once. A key is
+// removed as soon as its last value is, so KeyCount only counts keys that
+// have values.
+//
+// A ListMultiMap is not safe for concurrent use.
+type ListMultiMap[K comparable, V comparable] struct {
+	buckets map[K][]V
+	entries int
+}
+
+// Initialize a new, empty ListMultiMap.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: 1 map
+func NewListMultiMap[K comparable, V comparable]() *ListMultiMap[K, V] {
+	return &ListMultiMap[K, V]{buckets: map[K][]V{}}
+}
+
+// Add a value to the end of the values under a key.
+//
+// Time Complexity: O(1) amortized
+// Space Complexity: O(1)
+// Allocations: Slice resize.
+func (m *ListMultiMap[K, V]) Put(key K, value V) {
+	m.buckets[key] = append(m.buckets[key], value)
+	m.entries++
+}
+
+// Add each value to the end of the values under a key, in order.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements (variadic function argument). Slice resize.
+func (m *ListMultiMap[K, V]) PutAll(key K, values ...V) {
+	if len(values) == 0 {
+		return
+	}
+	m.buckets[key] = append(m.buckets[key], values...)
+	m.entries += len(values)
+}
+
+// Get a copy of the values under a key, in the order they were put. The
+// slice is empty if the key is not present.
+//
+// Time Complexity: O(n) (where n = values under key)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements.
+func (m *ListMultiMap[K, V]) Get(key K) []V {
+	return append([]V{}, m.buckets[key]...)
+}
+
+// Remove every occurrence of a value from under a key. Returns false if the
+// entry was not present.
+//
+// Time Complexity: O(n) (where n = values under key)
+// Space Complexity: O(1)
+// Allocations: None
+func (m *ListMultiMap[K, V]) Remove(key K, value V) bool {
+	bucket, ok := m.buckets[key]
+	if !ok {
+		return false
+	}
+	kept := SliceFilterInPlace(bucket, func(el V) bool { return el != value })
+	removed := len(bucket) - len(kept)
+	if removed == 0 {
+		return false
+	}
+	m.entries -= removed
+	if len(kept) == 0 {
+		delete(m.buckets, key)
+	} else {
+		m.buckets[key] = kept
+	}
+	return true
+}
+
+// Remove a key and every value under it, returning the values in the order
+// they were put. The slice is empty if the key was not present.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (m *ListMultiMap[K, V]) RemoveAll(key K) []V {
+	bucket, ok := m.buckets[key]
+	if !ok {
+		return []V{}
+	}
+	delete(m.buckets, key)
+	m.entries -= len(bucket)
+	return bucket
+}
+
+// Check if a key has any values.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (m *ListMultiMap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.buckets[key]
+	return ok
+}
+
+// Check if a value is present under a key.
+//
+// Time Complexity: O(n) (where n = values under key)
+// Space Complexity: O(1)
+// Allocations: None
+func (m *ListMultiMap[K, V]) ContainsEntry(key K, value V) bool {
+	return SliceContains(m.buckets[key], value)
+}
+
+// Get the number of keys that have at least one value.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (m *ListMultiMap[K, V]) KeyCount() int {
+	return len(m.buckets)
+}
+
+// Get the total number of entries across every key, counting repeats.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (m *ListMultiMap[K, V]) EntryCount() int {
+	return m.entries
+}
+
+// Create a new slice with every key, in no particular order.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements.
+func (m *ListMultiMap[K, V]) Keys() []K {
+	return MapKeys(m.buckets)
+}
+
+// Create a new ListMultiMap with every entry reversed, so each value maps to
+// the keys it was stored under, once for each time it was stored. Keys are
+// visited in no particular order, so the order of each new bucket is not
+// defined. Unlike BiMap.Inverse, the result is a copy.
+//
+// Time Complexity: O(n) (where n = number of entries)
+// Space Complexity: O(n)
+// Allocations: 1 map. 1 slice per distinct value, resized as necessary.
+func (m *ListMultiMap[K, V]) Inverse() *ListMultiMap[V, K] {
+	inverse := NewListMultiMap[V, K]()
+	for k, bucket := range m.buckets {
+		for _, v := range bucket {
+			inverse.Put(v, k)
+		}
+	}
+	return inverse
+}
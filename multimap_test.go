@@ -0,0 +1,138 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/RageCage64/collections-go"
+	"github.com/RageCage64/go-assert"
+)
+
+func TestSetMultiMap(t *testing.T) {
+	m := collections.NewSetMultiMap[string, int]()
+	assert.Assert(t, m.Put("a", 1), "expected a=1 to be added")
+	assert.Assert(t, !m.Put("a", 1), "expected a=1 to already be present")
+	m.PutAll("a", 2, 3)
+	m.PutAll("b", 1)
+	assert.Equal(t, 2, m.KeyCount())
+	assert.Equal(t, 4, m.EntryCount())
+
+	assert.Assert(t, m.Get("a").Equals(collections.NewSet(1, 2, 3)), "unexpected values %v", m.Get("a"))
+	assert.Equal(t, 0, len(m.Get("z")))
+	assert.Assert(t, m.ContainsEntry("b", 1), "expected b=1 to be present")
+	assert.Assert(t, !m.ContainsEntry("b", 2), "expected b=2 not to be present")
+	assert.Assert(t, !m.ContainsEntry("z", 1), "expected z=1 not to be present")
+
+	// Changing the result of Get does not change the map.
+	m.Get("a").Add(100)
+	assert.Assert(t, !m.ContainsEntry("a", 100), "expected Get to return a copy")
+
+	keys := m.Keys()
+	sort.Strings(keys)
+	assert.SliceEqual(t, []string{"a", "b"}, keys)
+}
+
+func TestSetMultiMapRemove(t *testing.T) {
+	m := collections.NewSetMultiMap[string, int]()
+	m.PutAll("a", 1, 2)
+	m.PutAll("b", 3)
+
+	assert.Assert(t, m.Remove("b", 3), "expected b=3 to be removed")
+	assert.Assert(t, !m.Remove("b", 3), "expected b=3 to already be removed")
+	assert.Assert(t, !m.ContainsKey("b"), "expected the empty bucket for b to be removed")
+	assert.Equal(t, 1, m.KeyCount())
+	assert.Equal(t, 2, m.EntryCount())
+
+	removed := m.RemoveAll("a")
+	assert.Assert(t, removed.Equals(collections.NewSet(1, 2)), "unexpected removed values %v", removed)
+	assert.Equal(t, 0, m.KeyCount())
+	assert.Equal(t, 0, m.EntryCount())
+	assert.Equal(t, 0, len(m.RemoveAll("a")))
+}
+
+func TestSetMultiMapInverse(t *testing.T) {
+	m := collections.NewSetMultiMap[string, string]()
+	m.PutAll("post1", "go", "db")
+	m.PutAll("post2", "go")
+
+	inv := m.Inverse()
+	assert.Equal(t, 2, inv.KeyCount())
+	assert.Equal(t, 3, inv.EntryCount())
+	assert.Assert(t, inv.Get("go").Equals(collections.NewSet("post1", "post2")), "unexpected values %v", inv.Get("go"))
+	assert.Assert(t, inv.Get("db").Equals(collections.NewSet("post1")), "unexpected values %v", inv.Get("db"))
+}
+
+func TestListMultiMap(t *testing.T) {
+	m := collections.NewListMultiMap[string, int]()
+	m.Put("a", 1)
+	m.Put("a", 1)
+	m.PutAll("a", 2, 3)
+	m.PutAll("b")
+	assert.Equal(t, 1, m.KeyCount())
+	assert.Equal(t, 4, m.EntryCount())
+	assert.SliceEqual(t, []int{1, 1, 2, 3}, m.Get("a"))
+	assert.SliceEqual(t, []int{}, m.Get("b"))
+	assert.Assert(t, m.ContainsEntry("a", 2), "expected a=2 to be present")
+	assert.Assert(t, !m.ContainsEntry("a", 4), "expected a=4 not to be present")
+
+	got := m.Get("a")
+	got[0] = 100
+	assert.SliceEqual(t, []int{1, 1, 2, 3}, m.Get("a"))
+
+	assert.SliceEqual(t, []string{"a"}, m.Keys())
+}
+
+func TestListMultiMapRemove(t *testing.T) {
+	m := collections.NewListMultiMap[string, int]()
+	m.PutAll("a", 1, 2, 1, 3)
+	m.PutAll("b", 4, 4)
+
+	assert.Assert(t, m.Remove("a", 1), "expected a=1 to be removed")
+	assert.SliceEqual(t, []int{2, 3}, m.Get("a"))
+	assert.Assert(t, !m.Remove("a", 1), "expected a=1 to already be removed")
+	assert.Assert(t, !m.Remove("z", 1), "expected z=1 not to be present")
+	assert.Equal(t, 4, m.EntryCount())
+
+	assert.Assert(t, m.Remove("b", 4), "expected b=4 to be removed")
+	assert.Assert(t, !m.ContainsKey("b"), "expected the empty bucket for b to be removed")
+	assert.Equal(t, 1, m.KeyCount())
+	assert.Equal(t, 2, m.EntryCount())
+
+	assert.SliceEqual(t, []int{2, 3}, m.RemoveAll("a"))
+	assert.Equal(t, 0, m.KeyCount())
+	assert.Equal(t, 0, m.EntryCount())
+	assert.SliceEqual(t, []int{}, m.RemoveAll("a"))
+}
+
+func TestListMultiMapInverse(t *testing.T) {
+	m := collections.NewListMultiMap[string, int]()
+	m.PutAll("a", 1, 2, 1)
+	m.PutAll("b", 1)
+
+	inv := m.Inverse()
+	assert.Equal(t, 2, inv.KeyCount())
+	assert.Equal(t, 4, inv.EntryCount())
+	ones := inv.Get(1)
+	sort.Strings(ones)
+	assert.SliceEqual(t, []string{"a", "a", "b"}, ones)
+	assert.SliceEqual(t, []string{"a"}, inv.Get(2))
+}
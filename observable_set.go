@@ -0,0 +1,300 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections
+
+import "sync"
+
+// ChangeKind describes how the membership of an ObservableSet changed.
+type ChangeKind int
+
+const (
+	// ChangeAdded means the element was not in the set and now is.
+	ChangeAdded ChangeKind = iota
+	// ChangeRemoved means the element was in the set and now is not.
+	ChangeRemoved
+)
+
+// String returns "Added" or "Removed".
+func (k ChangeKind) String() string {
+	if k == ChangeAdded {
+		return "Added"
+	}
+	return "Removed"
+}
+
+// Change is a single membership change of an ObservableSet.
+type Change[T comparable] struct {
+	Kind  ChangeKind
+	Value T
+}
+
+// Subscriber is a function that receives the changes of an ObservableSet.
+// Outside of a batch it is called with exactly one change at a time.
+type Subscriber[T comparable] func(changes []Change[T])
+
+// SubscriptionID identifies a subscriber so it can be removed again with
+// Unsubscribe.
+type SubscriptionID uint64
+
+// ObservableSet wraps a Set and notifies subscribers whenever an element is
+// added or removed. Notifications are only sent for real membership changes,
+// so adding an element that is already present or removing one that is not
+// does nothing. An ObservableSet is safe for concurrent use, and subscribers
+// are called after the set's lock is released so they may read or change the
+// set.
+//
+// Notifications are delivered one at a time, in the order the changes were
+// made, so a subscriber that mirrors the set's membership stays correct even
+// when several goroutines change the set at once. To keep that order, only
+// one goroutine delivers at a time. If another goroutine is already
+// delivering when a change is made, that goroutine delivers the new
+// notification as well, and Add or Remove may return before the subscribers
+// have been called.
+type ObservableSet[T comparable] struct {
+	mu          sync.Mutex
+	set         Set[T]
+	subscribers map[SubscriptionID]func([]Change[T])
+	nextID      SubscriptionID
+	batchDepth  int
+	pending     []Change[T]
+	// queue holds the notifications waiting to be delivered, and delivering
+	// is true while a goroutine is working through it.
+	queue      []notification[T]
+	delivering bool
+}
+
+type notification[T comparable] struct {
+	changes     []Change[T]
+	subscribers []func([]Change[T])
+}
+
+// Initialize a new ObservableSet containing the given elements. No
+// notifications are sent for the initial elements.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements (variadic function argument). 1 set, n elements.
+func NewObservableSet[T comparable](elements ...T) *ObservableSet[T] {
+	return &ObservableSet[T]{
+		set:         NewSetFromSlice(elements),
+		subscribers: map[SubscriptionID]func([]Change[T]){},
+	}
+}
+
+// Subscribe registers a function that is called with each Change. Inside a
+// batch (see Batch) the function is not called with the batch as a whole.
+// It is called once for each change that remains after coalescing, when the
+// batch ends. Use SubscribeBatch to receive the whole batch in one call.
+func (s *ObservableSet[T]) Subscribe(fn func(Change[T])) SubscriptionID {
+	return s.subscribe(func(changes []Change[T]) {
+		for i := 0; i < len(changes); i++ {
+			fn(changes[i])
+		}
+	})
+}
+
+// SubscribeBatch registers a Subscriber that is called with every change
+// made during a batch in a single notification.
+func (s *ObservableSet[T]) SubscribeBatch(fn Subscriber[T]) SubscriptionID {
+	return s.subscribe(fn)
+}
+
+func (s *ObservableSet[T]) subscribe(fn func([]Change[T])) SubscriptionID {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextID++
+	s.subscribers[s.nextID] = fn
+	return s.nextID
+}
+
+// Unsubscribe stops a subscriber from receiving any further changes.
+func (s *ObservableSet[T]) Unsubscribe(id SubscriptionID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.subscribers, id)
+}
+
+// Add an element to the set, notifying subscribers if it was not already
+// present.
+//
+// Time Complexity: O(1) + notification
+// Space Complexity: O(1)
+// Allocations: Set resize. 1 change slice per notification.
+func (s *ObservableSet[T]) Add(el T) {
+	s.mu.Lock()
+	if s.set.Contains(el) {
+		s.mu.Unlock()
+		return
+	}
+	s.set.Add(el)
+	s.record(Change[T]{Kind: ChangeAdded, Value: el})
+}
+
+// Remove an element from the set, notifying subscribers if it was present.
+//
+// Time Complexity: O(1) + notification
+// Space Complexity: O(1)
+// Allocations: 1 change slice per notification.
+func (s *ObservableSet[T]) Remove(el T) {
+	s.mu.Lock()
+	if !s.set.Contains(el) {
+		s.mu.Unlock()
+		return
+	}
+	s.set.Remove(el)
+	s.record(Change[T]{Kind: ChangeRemoved, Value: el})
+}
+
+// Check if the set contains a particular value.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (s *ObservableSet[T]) Contains(el T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.set.Contains(el)
+}
+
+// Len returns the number of elements in the set.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (s *ObservableSet[T]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.set)
+}
+
+// Snapshot returns a copy of the underlying Set.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 1 set of n elements
+func (s *ObservableSet[T]) Snapshot() Set[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.set.Clone()
+}
+
+// Batch runs fn and delivers every change it makes in a single notification
+// once it returns. An element that is added and then removed again inside
+// the same batch (or the other way round) produces no change at all. Batches
+// may be nested, in which case notifications are sent when the outermost
+// batch ends. Changes made by other goroutines while a batch is running are
+// delivered in the same notification.
+//
+// Only subscribers registered with SubscribeBatch receive the notification
+// as one call. A function registered with Subscribe is still called once for
+// each remaining change, after the batch ends.
+func (s *ObservableSet[T]) Batch(fn func(set *ObservableSet[T])) {
+	s.mu.Lock()
+	s.batchDepth++
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		s.batchDepth--
+		if s.batchDepth > 0 {
+			s.mu.Unlock()
+			return
+		}
+		changes := coalesceChanges(s.pending)
+		s.pending = nil
+		s.notify(changes)
+	}()
+	fn(s)
+}
+
+// record must be called with the lock held, and releases it.
+func (s *ObservableSet[T]) record(change Change[T]) {
+	if s.batchDepth > 0 {
+		s.pending = append(s.pending, change)
+		s.mu.Unlock()
+		return
+	}
+	s.notify([]Change[T]{change})
+}
+
+// notify must be called with the lock held, and releases it before calling
+// the subscribers. The notification is queued, and unless another goroutine
+// is already delivering, this goroutine delivers everything in the queue.
+func (s *ObservableSet[T]) notify(changes []Change[T]) {
+	if len(changes) == 0 || len(s.subscribers) == 0 {
+		s.mu.Unlock()
+		return
+	}
+	subscribers := make([]func([]Change[T]), 0, len(s.subscribers))
+	for _, fn := range s.subscribers {
+		subscribers = append(subscribers, fn)
+	}
+	s.queue = append(s.queue, notification[T]{changes: changes, subscribers: subscribers})
+	if s.delivering {
+		s.mu.Unlock()
+		return
+	}
+	s.delivering = true
+
+	finished := false
+	defer func() {
+		// If a subscriber panicked, let the next change take over delivery.
+		if !finished {
+			s.mu.Lock()
+			s.delivering = false
+			s.mu.Unlock()
+		}
+	}()
+	for len(s.queue) > 0 {
+		next := s.queue[0]
+		s.queue[0] = notification[T]{}
+		s.queue = s.queue[1:]
+		s.mu.Unlock()
+		for i := 0; i < len(next.subscribers); i++ {
+			next.subscribers[i](next.changes)
+		}
+		s.mu.Lock()
+	}
+	s.queue = nil
+	s.delivering = false
+	finished = true
+	s.mu.Unlock()
+}
+
+// coalesceChanges drops pairs of changes that cancel each other out. Since
+// changes are only recorded for real membership changes, the changes for any
+// one element always alternate between ChangeAdded and ChangeRemoved, so an
+// element only has a net change if it was changed an odd number of times.
+func coalesceChanges[T comparable](changes []Change[T]) []Change[T] {
+	counts := make(map[T]int, len(changes))
+	for i := 0; i < len(changes); i++ {
+		counts[changes[i].Value]++
+	}
+	result := changes[:0]
+	for i := 0; i < len(changes); i++ {
+		count := counts[changes[i].Value]
+		if count%2 == 1 {
+			result = append(result, changes[i])
+			// Prevent any later change to the same element being kept.
+			counts[changes[i].Value] = 0
+		}
+	}
+	return result
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/RageCage64/collections-go"
+	"github.com/RageCage64/go-assert"
+)
+
+func TestObservableSet(t *testing.T) {
+	set := collections.NewObservableSet(1)
+	var changes []collections.Change[int]
+	id := set.Subscribe(func(c collections.Change[int]) {
+		changes = append(changes, c)
+	})
+
+	set.Add(1)
+	set.Add(2)
+	set.Remove(3)
+	set.Remove(1)
+	assert.SliceEqual(
+		t,
+		[]collections.Change[int]{
+			{Kind: collections.ChangeAdded, Value: 2},
+			{Kind: collections.ChangeRemoved, Value: 1},
+		},
+		changes,
+	)
+
+	set.Unsubscribe(id)
+	set.Add(4)
+	assert.Equal(t, 2, len(changes))
+	assert.Equal(t, 2, set.Len())
+}
+
+func TestObservableSetBatch(t *testing.T) {
+	set := collections.NewObservableSet(1, 2)
+	var notifications [][]collections.Change[int]
+	set.SubscribeBatch(func(changes []collections.Change[int]) {
+		notifications = append(notifications, changes)
+	})
+
+	set.Batch(func(s *collections.ObservableSet[int]) {
+		s.Add(3)
+		s.Add(4)
+		s.Remove(4)
+		s.Remove(1)
+		s.Add(1)
+		s.Remove(2)
+	})
+	assert.Equal(t, 1, len(notifications))
+	assert.SliceEqual(
+		t,
+		[]collections.Change[int]{
+			{Kind: collections.ChangeAdded, Value: 3},
+			{Kind: collections.ChangeRemoved, Value: 2},
+		},
+		notifications[0],
+	)
+	compareSetAndSlice(t, set.Snapshot(), []int{1, 3})
+}
+
+func TestObservableSetEmptyBatch(t *testing.T) {
+	set := collections.NewObservableSet[int]()
+	notified := false
+	set.SubscribeBatch(func([]collections.Change[int]) {
+		notified = true
+	})
+
+	set.Batch(func(s *collections.ObservableSet[int]) {
+		s.Add(1)
+		s.Remove(1)
+	})
+	assert.Assert(t, !notified, "expected no notification for a batch with no net change")
+}
+
+func TestObservableSetBatchPlainSubscriber(t *testing.T) {
+	set := collections.NewObservableSet(1)
+	var changes []collections.Change[int]
+	set.Subscribe(func(change collections.Change[int]) {
+		changes = append(changes, change)
+	})
+
+	set.Batch(func(s *collections.ObservableSet[int]) {
+		s.Add(2)
+		s.Add(3)
+		s.Remove(3)
+		s.Remove(1)
+		assert.Equal(t, 0, len(changes))
+	})
+	assert.SliceEqual(
+		t,
+		[]collections.Change[int]{
+			{Kind: collections.ChangeAdded, Value: 2},
+			{Kind: collections.ChangeRemoved, Value: 1},
+		},
+		changes,
+	)
+}
+
+func TestObservableSetConcurrentOrder(t *testing.T) {
+	set := collections.NewObservableSet[int]()
+	// Deliveries never overlap, so the mirror needs no lock of its own.
+	mirror := collections.NewSet[int]()
+	invalid := 0
+	set.Subscribe(func(c collections.Change[int]) {
+		if (c.Kind == collections.ChangeAdded) == mirror.Contains(c.Value) {
+			invalid++
+		}
+		if c.Kind == collections.ChangeAdded {
+			mirror.Add(c.Value)
+		} else {
+			mirror.Remove(c.Value)
+		}
+	})
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 2000; i++ {
+				el := i % 3
+				if (g+i)%2 == 0 {
+					set.Add(el)
+				} else {
+					set.Remove(el)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 0, invalid)
+	assert.Assert(t, mirror.Equals(set.Snapshot()), "expected mirror %v to match %v", mirror, set.Snapshot())
+}
+
+func TestObservableSetSubscriberPanic(t *testing.T) {
+	set := collections.NewObservableSet[int]()
+	var changes []collections.Change[int]
+	set.Subscribe(func(c collections.Change[int]) {
+		if c.Value == 1 {
+			panic("boom")
+		}
+		changes = append(changes, c)
+	})
+
+	func() {
+		defer func() {
+			assert.Assert(t, recover() != nil, "expected the panic to be passed on")
+		}()
+		set.Add(1)
+	}()
+	set.Add(2)
+	assert.SliceEqual(t, []collections.Change[int]{{Kind: collections.ChangeAdded, Value: 2}}, changes)
+}
@@ -0,0 +1,380 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// OrderedMap is a map that remembers the order its keys were first set in.
+// Each entry is held in a doubly linked list of LLNodes, with a hash index
+// from key to node, so lookups, inserts, deletes and moves are all O(1).
+// Iteration and JSON encoding follow the list order.
+//
+// The zero value is an empty map ready to use. An OrderedMap is not safe for
+// concurrent use.
+type OrderedMap[K comparable, V any] struct {
+	index map[K]*LLNode[Pair[K, V]]
+	front *LLNode[Pair[K, V]]
+	back  *LLNode[Pair[K, V]]
+}
+
+// Initialize a new, empty OrderedMap.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: 1 map
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{index: map[K]*LLNode[Pair[K, V]]{}}
+}
+
+// Set the value for a key. A new key is added at the back. Setting a key
+// that is already present updates its value without changing its position.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: 1 node for a new key. Map resize.
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	if node, ok := m.index[key]; ok {
+		node.Value.Second = value
+		return
+	}
+	if m.index == nil {
+		m.index = map[K]*LLNode[Pair[K, V]]{}
+	}
+	node := &LLNode[Pair[K, V]]{Value: Pair[K, V]{First: key, Second: value}}
+	m.index[key] = node
+	m.pushBack(node)
+}
+
+// Get the value for a key, and whether the key was present.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	if node, ok := m.index[key]; ok {
+		return node.Value.Second, true
+	}
+	return *new(V), false
+}
+
+// Check if the map contains a key.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (m *OrderedMap[K, V]) Has(key K) bool {
+	_, ok := m.index[key]
+	return ok
+}
+
+// Remove a key from the map. Returns false if the key was not present.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	node, ok := m.index[key]
+	if !ok {
+		return false
+	}
+	delete(m.index, key)
+	m.unlink(node)
+	return true
+}
+
+// Get the number of entries in the map.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.index)
+}
+
+// Create a new slice with every key, in order.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements.
+func (m *OrderedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.Len())
+	for node := m.front; node != nil; node = node.Next {
+		keys = append(keys, node.Value.First)
+	}
+	return keys
+}
+
+// Create a new slice with every value, in the order of their keys.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements.
+func (m *OrderedMap[K, V]) Values() []V {
+	values := make([]V, 0, m.Len())
+	for node := m.front; node != nil; node = node.Next {
+		values = append(values, node.Value.Second)
+	}
+	return values
+}
+
+// Get the first entry. Returns false if the map is empty.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (m *OrderedMap[K, V]) Front() (K, V, bool) {
+	if m.front == nil {
+		return *new(K), *new(V), false
+	}
+	return m.front.Value.First, m.front.Value.Second, true
+}
+
+// Get the last entry. Returns false if the map is empty.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (m *OrderedMap[K, V]) Back() (K, V, bool) {
+	if m.back == nil {
+		return *new(K), *new(V), false
+	}
+	return m.back.Value.First, m.back.Value.Second, true
+}
+
+// Move a key to the front of the order. Returns false if the key was not
+// present.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (m *OrderedMap[K, V]) MoveToFront(key K) bool {
+	node, ok := m.index[key]
+	if !ok {
+		return false
+	}
+	if node != m.front {
+		m.unlink(node)
+		m.pushFront(node)
+	}
+	return true
+}
+
+// Move a key to the back of the order. Returns false if the key was not
+// present.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (m *OrderedMap[K, V]) MoveToBack(key K) bool {
+	node, ok := m.index[key]
+	if !ok {
+		return false
+	}
+	if node != m.back {
+		m.unlink(node)
+		m.pushBack(node)
+	}
+	return true
+}
+
+// Call fn with each entry from front to back, stopping early if it returns
+// false. It is safe to delete the current entry from inside fn.
+//
+// Time Complexity: O(n * m) (where m = complexity of fn)
+// Space Complexity: O(1)
+// Allocations: None
+func (m *OrderedMap[K, V]) Range(fn func(key K, value V) bool) {
+	for node := m.front; node != nil; {
+		next := node.Next
+		if !fn(node.Value.First, node.Value.Second) {
+			return
+		}
+		node = next
+	}
+}
+
+// Call fn with each entry from back to front, stopping early if it returns
+// false. It is safe to delete the current entry from inside fn.
+//
+// Time Complexity: O(n * m) (where m = complexity of fn)
+// Space Complexity: O(1)
+// Allocations: None
+func (m *OrderedMap[K, V]) RangeReverse(fn func(key K, value V) bool) {
+	for node := m.back; node != nil; {
+		prev := node.Prev
+		if !fn(node.Value.First, node.Value.Second) {
+			return
+		}
+		node = prev
+	}
+}
+
+func (m *OrderedMap[K, V]) pushBack(node *LLNode[Pair[K, V]]) {
+	node.Prev, node.Next = m.back, nil
+	if m.back != nil {
+		m.back.Next = node
+	} else {
+		m.front = node
+	}
+	m.back = node
+}
+
+func (m *OrderedMap[K, V]) pushFront(node *LLNode[Pair[K, V]]) {
+	node.Prev, node.Next = nil, m.front
+	if m.front != nil {
+		m.front.Prev = node
+	} else {
+		m.back = node
+	}
+	m.front = node
+}
+
+func (m *OrderedMap[K, V]) unlink(node *LLNode[Pair[K, V]]) {
+	if node == m.front {
+		m.front = node.Next
+	}
+	if node == m.back {
+		m.back = node.Prev
+	}
+	node.RemoveSelf()
+}
+
+// MarshalJSON encodes the map as a JSON object with its keys in order. Keys
+// follow the same rules as encoding/json uses for maps: they must be a
+// string or integer type, or implement encoding.TextMarshaler. It has a
+// value receiver so that an OrderedMap held by value, such as in a struct
+// field or a map, is still encoded with its entries.
+func (m OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for node := m.front; node != nil; node = node.Next {
+		if node != m.front {
+			buf.WriteByte(',')
+		}
+		keyText, err := orderedMapKeyText(node.Value.First)
+		if err != nil {
+			return nil, err
+		}
+		key, err := json.Marshal(keyText)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(node.Value.Second)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON replaces the contents of the map with the entries of a JSON
+// object, in the order they appear. If a key appears more than once, the
+// last value wins but the key keeps its first position. A JSON null empties
+// the map.
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	*m = OrderedMap[K, V]{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("collections: cannot unmarshal %v into OrderedMap", tok)
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, err := orderedMapParseKey[K](tok.(string))
+		if err != nil {
+			return err
+		}
+		var value V
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+		m.Set(key, value)
+	}
+	_, err = dec.Token()
+	return err
+}
+
+func orderedMapKeyText[K comparable](key K) (string, error) {
+	rv := reflect.ValueOf(&key).Elem()
+	if rv.Kind() == reflect.String {
+		return rv.String(), nil
+	}
+	if tm, ok := any(key).(encoding.TextMarshaler); ok {
+		text, err := tm.MarshalText()
+		return string(text), err
+	}
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	}
+	return "", fmt.Errorf("collections: unsupported OrderedMap key type %T for JSON", key)
+}
+
+func orderedMapParseKey[K comparable](text string) (K, error) {
+	var key K
+	rv := reflect.ValueOf(&key).Elem()
+	if rv.Kind() == reflect.String {
+		rv.SetString(text)
+		return key, nil
+	}
+	if tu, ok := any(&key).(encoding.TextUnmarshaler); ok {
+		err := tu.UnmarshalText([]byte(text))
+		return key, err
+	}
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(text, 10, rv.Type().Bits())
+		if err != nil {
+			return key, fmt.Errorf("collections: invalid OrderedMap key %q: %w", text, err)
+		}
+		rv.SetInt(n)
+		return key, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(text, 10, rv.Type().Bits())
+		if err != nil {
+			return key, fmt.Errorf("collections: invalid OrderedMap key %q: %w", text, err)
+		}
+		rv.SetUint(n)
+		return key, nil
+	}
+	return key, fmt.Errorf("collections: unsupported OrderedMap key type %T for JSON", key)
+}
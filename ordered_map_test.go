@@ -0,0 +1,196 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/RageCage64/collections-go"
+	"github.com/RageCage64/go-assert"
+)
+
+func TestOrderedMap(t *testing.T) {
+	var m collections.OrderedMap[string, int]
+	m.Set("c", 3)
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("a", 10)
+
+	assert.Equal(t, 3, m.Len())
+	assert.SliceEqual(t, []string{"c", "a", "b"}, m.Keys())
+	assert.SliceEqual(t, []int{3, 10, 2}, m.Values())
+
+	v, ok := m.Get("a")
+	assert.Assert(t, ok, "expected a to be present")
+	assert.Equal(t, 10, v)
+	_, ok = m.Get("z")
+	assert.Assert(t, !ok, "expected z not to be present")
+	assert.Assert(t, m.Has("b"), "expected b to be present")
+
+	assert.Assert(t, m.Delete("a"), "expected a to be deleted")
+	assert.Assert(t, !m.Delete("a"), "expected a to already be deleted")
+	assert.SliceEqual(t, []string{"c", "b"}, m.Keys())
+	m.Set("a", 1)
+	assert.SliceEqual(t, []string{"c", "b", "a"}, m.Keys())
+}
+
+func TestOrderedMapFrontBack(t *testing.T) {
+	m := collections.NewOrderedMap[string, int]()
+	_, _, ok := m.Front()
+	assert.Assert(t, !ok, "expected no front of an empty map")
+	_, _, ok = m.Back()
+	assert.Assert(t, !ok, "expected no back of an empty map")
+
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+	k, v, _ := m.Front()
+	assert.Equal(t, "a", k)
+	assert.Equal(t, 1, v)
+	k, v, _ = m.Back()
+	assert.Equal(t, "c", k)
+	assert.Equal(t, 3, v)
+
+	assert.Assert(t, m.MoveToFront("c"), "expected c to move")
+	assert.SliceEqual(t, []string{"c", "a", "b"}, m.Keys())
+	assert.Assert(t, m.MoveToBack("c"), "expected c to move")
+	assert.SliceEqual(t, []string{"a", "b", "c"}, m.Keys())
+	assert.Assert(t, m.MoveToBack("a"), "expected a to move")
+	assert.SliceEqual(t, []string{"b", "c", "a"}, m.Keys())
+	assert.Assert(t, !m.MoveToFront("z"), "expected z not to move")
+
+	m.Delete("b")
+	m.Delete("a")
+	k, _, _ = m.Front()
+	assert.Equal(t, "c", k)
+	k, _, _ = m.Back()
+	assert.Equal(t, "c", k)
+	m.Delete("c")
+	_, _, ok = m.Front()
+	assert.Assert(t, !ok, "expected no front after deleting everything")
+}
+
+func TestOrderedMapRange(t *testing.T) {
+	m := collections.NewOrderedMap[int, string]()
+	for _, i := range collections.Range(5) {
+		m.Set(i, "")
+	}
+
+	var forward []int
+	m.Range(func(k int, _ string) bool {
+		forward = append(forward, k)
+		if k%2 == 1 {
+			m.Delete(k)
+		}
+		return true
+	})
+	assert.SliceEqual(t, []int{0, 1, 2, 3, 4}, forward)
+	assert.SliceEqual(t, []int{0, 2, 4}, m.Keys())
+
+	var backward []int
+	m.RangeReverse(func(k int, _ string) bool {
+		backward = append(backward, k)
+		return k != 2
+	})
+	assert.SliceEqual(t, []int{4, 2}, backward)
+}
+
+func TestOrderedMapJSON(t *testing.T) {
+	m := collections.NewOrderedMap[string, []int]()
+	m.Set("zebra", []int{1})
+	m.Set("apple", nil)
+	m.Set("mango", []int{2, 3})
+
+	data, err := json.Marshal(m)
+	assert.NilErr(t, err)
+	assert.Equal(t, `{"zebra":[1],"apple":null,"mango":[2,3]}`, string(data))
+
+	var decoded collections.OrderedMap[string, []int]
+	assert.NilErr(t, json.Unmarshal([]byte(`{"b":[1], "a":[2], "b":[3]}`), &decoded))
+	assert.SliceEqual(t, []string{"b", "a"}, decoded.Keys())
+	v, _ := decoded.Get("b")
+	assert.SliceEqual(t, []int{3}, v)
+
+	assert.NilErr(t, json.Unmarshal([]byte(`null`), &decoded))
+	assert.Equal(t, 0, decoded.Len())
+
+	err = json.Unmarshal([]byte(`[1]`), &decoded)
+	assert.Assert(t, err != nil, "expected an error decoding an array")
+}
+
+func TestOrderedMapJSONIntKeys(t *testing.T) {
+	m := collections.NewOrderedMap[int8, string]()
+	m.Set(3, "c")
+	m.Set(-1, "a")
+	data, err := json.Marshal(m)
+	assert.NilErr(t, err)
+	assert.Equal(t, `{"3":"c","-1":"a"}`, string(data))
+
+	var decoded collections.OrderedMap[int8, string]
+	assert.NilErr(t, json.Unmarshal(data, &decoded))
+	assert.SliceEqual(t, []int8{3, -1}, decoded.Keys())
+
+	err = json.Unmarshal([]byte(`{"300":"x"}`), &decoded)
+	assert.Assert(t, err != nil, "expected an error for an out of range key")
+}
+
+func TestOrderedMapJSONNested(t *testing.T) {
+	type config struct {
+		Env *collections.OrderedMap[string, string] `json:"env"`
+	}
+	var c config
+	assert.NilErr(t, json.Unmarshal([]byte(`{"env":{"PATH":"/bin","HOME":"/root"}}`), &c))
+	assert.SliceEqual(t, []string{"PATH", "HOME"}, c.Env.Keys())
+
+	data, err := json.Marshal(c)
+	assert.NilErr(t, err)
+	assert.Equal(t, `{"env":{"PATH":"/bin","HOME":"/root"}}`, string(data))
+}
+
+func TestOrderedMapJSONByValue(t *testing.T) {
+	m := collections.NewOrderedMap[string, int]()
+	m.Set("z", 1)
+	m.Set("a", 2)
+
+	type holder struct {
+		M collections.OrderedMap[string, int] `json:"m"`
+	}
+	data, err := json.Marshal(holder{M: *m})
+	assert.NilErr(t, err)
+	assert.Equal(t, `{"m":{"z":1,"a":2}}`, string(data))
+
+	var decoded holder
+	assert.NilErr(t, json.Unmarshal(data, &decoded))
+	assert.SliceEqual(t, []string{"z", "a"}, decoded.M.Keys())
+
+	data, err = json.Marshal(map[string]collections.OrderedMap[string, int]{"k": *m})
+	assert.NilErr(t, err)
+	assert.Equal(t, `{"k":{"z":1,"a":2}}`, string(data))
+
+	data, err = json.Marshal([]collections.OrderedMap[string, int]{*m, {}})
+	assert.NilErr(t, err)
+	assert.Equal(t, `[{"z":1,"a":2},{}]`, string(data))
+
+	var nilMap *collections.OrderedMap[string, int]
+	data, err = json.Marshal(nilMap)
+	assert.NilErr(t, err)
+	assert.Equal(t, `null`, string(data))
+}
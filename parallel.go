@@ -0,0 +1,305 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+)
+
+// MapperErr is a Mapper that can fail.
+type MapperErr[T any, U any] func(T) (U, error)
+
+// ParallelOptions controls how the Parallel functions split up their work.
+// The zero value is ready to use.
+type ParallelOptions struct {
+	// Workers is the maximum number of goroutines to run at once. Defaults
+	// to runtime.GOMAXPROCS(0) when zero or negative.
+	Workers int
+	// ChunkSize is the number of consecutive elements a worker takes at a
+	// time. Larger chunks mean less coordination overhead, smaller chunks
+	// balance uneven work better. Defaults to splitting the slice into
+	// roughly four chunks per worker when zero or negative.
+	ChunkSize int
+}
+
+func (o ParallelOptions) resolve(n int) (workers int, chunkSize int) {
+	workers = o.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	chunkSize = o.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = n / (workers * 4)
+		if chunkSize < 1 {
+			chunkSize = 1
+		}
+	}
+	chunks := (n + chunkSize - 1) / chunkSize
+	if workers > chunks {
+		workers = chunks
+	}
+	return workers, chunkSize
+}
+
+// PanicError holds the value of a panic that happened inside a Parallel
+// function's callback. The Parallel functions re-panic with a *PanicError in
+// the calling goroutine, so the panic can be recovered by the caller.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (p *PanicError) Error() string {
+	return fmt.Sprintf("collections: panic in parallel callback: %v\n\n%s", p.Value, p.Stack)
+}
+
+// Unwrap returns the panic value if it was an error.
+func (p *PanicError) Unwrap() error {
+	if err, ok := p.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+/*****************
+The parallel direct API. Each function splits the slice into chunks that are
+processed by a bounded number of worker goroutines. The first error returned
+by a callback (as an *IndexError) or the first panic (as a *PanicError) stops
+any chunks that haven't started yet and is reported to the caller. Which error
+is first depends on scheduling, so it is not necessarily the one with the
+lowest index. If ctx is cancelled before every chunk has been processed, the
+context's error is returned.
+*****************/
+
+// Run a mapper on every element in the slice in parallel, and return a slice
+// that contains the result of every operation in the same order as the input.
+//
+// Time Complexity: O(n * m / w) (where m = complexity of mapper, w = workers)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements. w goroutines.
+func ParallelMap[T any, U any](
+	ctx context.Context,
+	sl []T,
+	opts ParallelOptions,
+	mapper MapperErr[T, U],
+) ([]U, error) {
+	result := make([]U, len(sl))
+	err := parallelChunks(ctx, len(sl), opts, func(lo int, hi int) error {
+		for i := lo; i < hi; i++ {
+			mapped, err := mapper(sl[i])
+			if err != nil {
+				return &IndexError{Index: i, Err: err}
+			}
+			result[i] = mapped
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Run a predicate on every element in the slice in parallel, and return a
+// slice that contains every element for which the predicate was true in the
+// same order as the input.
+//
+// Time Complexity: O(n * m / w + n) (where m = complexity of predicate, w = workers)
+// Space Complexity: O(n)
+// Allocations: 1 bool slice, n elements. 1 slice, k elements (where k is
+// the number of elements kept). w goroutines.
+func ParallelFilter[T any](
+	ctx context.Context,
+	sl []T,
+	opts ParallelOptions,
+	pred UnaryPredicateErr[T],
+) ([]T, error) {
+	keep := make([]bool, len(sl))
+	var kept atomic.Int64
+	err := parallelChunks(ctx, len(sl), opts, func(lo int, hi int) error {
+		n := int64(0)
+		for i := lo; i < hi; i++ {
+			ok, err := pred(sl[i])
+			if err != nil {
+				return &IndexError{Index: i, Err: err}
+			}
+			if ok {
+				keep[i] = true
+				n++
+			}
+		}
+		kept.Add(n)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]T, 0, kept.Load())
+	for i := 0; i < len(sl); i++ {
+		if keep[i] {
+			result = append(result, sl[i])
+		}
+	}
+	return result, nil
+}
+
+// Run an operation using every element of the slice in parallel. There is
+// no guarantee about the order the elements are visited in.
+//
+// Time Complexity: O(n * m / w) (where m = complexity of the operation, w = workers)
+// Space Complexity: O(1)
+// Allocations: w goroutines.
+func ParallelForEach[T any](
+	ctx context.Context,
+	sl []T,
+	opts ParallelOptions,
+	do UnaryReceiverErr[T],
+) error {
+	return parallelChunks(ctx, len(sl), opts, func(lo int, hi int) error {
+		for i := lo; i < hi; i++ {
+			if err := do(sl[i]); err != nil {
+				return &IndexError{Index: i, Err: err}
+			}
+		}
+		return nil
+	})
+}
+
+// Reduce the slice in parallel. Each chunk is reduced separately starting
+// from identity, then the chunk results are merged left to right with
+// combine. For the result to match SliceReduce, identity must not change a
+// value it is combined with, and combine must be associative. Combine does
+// not need to be commutative.
+//
+// Time Complexity: O(n * m / w + c) (where m = complexity of reducer,
+// w = workers, c = number of chunks)
+// Space Complexity: O(c)
+// Allocations: 1 slice, c elements. w goroutines.
+func ParallelReduce[Acc any, T any](
+	ctx context.Context,
+	sl []T,
+	opts ParallelOptions,
+	identity Acc,
+	reducer ReducerErr[Acc, T],
+	combine func(left Acc, right Acc) Acc,
+) (Acc, error) {
+	_, chunkSize := opts.resolve(len(sl))
+	partials := make([]Acc, (len(sl)+chunkSize-1)/chunkSize)
+	err := parallelChunks(ctx, len(sl), opts, func(lo int, hi int) error {
+		acc := identity
+		for i := lo; i < hi; i++ {
+			next, err := reducer(acc, sl[i])
+			if err != nil {
+				return &IndexError{Index: i, Err: err}
+			}
+			acc = next
+		}
+		partials[lo/chunkSize] = acc
+		return nil
+	})
+	if err != nil {
+		return identity, err
+	}
+	result := identity
+	for i := 0; i < len(partials); i++ {
+		result = combine(result, partials[i])
+	}
+	return result, nil
+}
+
+// parallelChunks calls fn for each chunk [lo, hi) of [0, n) across a pool of
+// workers. It returns the first error, or re-panics with the first panic.
+func parallelChunks(
+	ctx context.Context,
+	n int,
+	opts ParallelOptions,
+	fn func(lo int, hi int) error,
+) error {
+	if n == 0 {
+		return ctx.Err()
+	}
+	workers, chunkSize := opts.resolve(n)
+	chunks := int64((n + chunkSize - 1) / chunkSize)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		next      atomic.Int64
+		done      atomic.Int64
+		firstOnce sync.Once
+		firstErr  error
+		wg        sync.WaitGroup
+	)
+	fail := func(err error) {
+		firstOnce.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+	runChunk := func(chunk int64) {
+		defer func() {
+			if r := recover(); r != nil {
+				fail(&PanicError{Value: r, Stack: debug.Stack()})
+			}
+		}()
+		lo := int(chunk) * chunkSize
+		hi := lo + chunkSize
+		if hi > n {
+			hi = n
+		}
+		if err := fn(lo, hi); err != nil {
+			fail(err)
+			return
+		}
+		done.Add(1)
+	}
+
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				chunk := next.Add(1) - 1
+				if chunk >= chunks {
+					return
+				}
+				runChunk(chunk)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if p, ok := firstErr.(*PanicError); ok {
+		panic(p)
+	}
+	if firstErr != nil {
+		return firstErr
+	}
+	if done.Load() < chunks {
+		return ctx.Err()
+	}
+	return nil
+}
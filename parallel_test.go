@@ -0,0 +1,153 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/RageCage64/collections-go"
+	"github.com/RageCage64/go-assert"
+)
+
+var parallelOpts = collections.ParallelOptions{Workers: 4, ChunkSize: 7}
+
+func TestParallelMap(t *testing.T) {
+	input := collections.Range(1000)
+	result, err := collections.ParallelMap(
+		context.Background(),
+		input,
+		parallelOpts,
+		func(x int) (string, error) {
+			return strconv.Itoa(x), nil
+		},
+	)
+	assert.NilErr(t, err)
+	assert.SliceEqual(t, collections.SliceMapTo(input, strconv.Itoa), result)
+}
+
+func TestParallelFilter(t *testing.T) {
+	input := collections.Range(1000)
+	isEven := func(x int) bool { return x%2 == 0 }
+	result, err := collections.ParallelFilter(
+		context.Background(),
+		input,
+		parallelOpts,
+		func(x int) (bool, error) {
+			return isEven(x), nil
+		},
+	)
+	assert.NilErr(t, err)
+	assert.SliceEqual(t, collections.SliceFilter(input, isEven), result)
+}
+
+func TestParallelForEach(t *testing.T) {
+	var sum atomic.Int64
+	err := collections.ParallelForEach(
+		context.Background(),
+		collections.Range(100),
+		collections.ParallelOptions{},
+		func(x int) error {
+			sum.Add(int64(x))
+			return nil
+		},
+	)
+	assert.NilErr(t, err)
+	assert.Equal(t, int64(4950), sum.Load())
+}
+
+func TestParallelReduce(t *testing.T) {
+	// String concatenation is associative but not commutative, so this also
+	// checks that chunks are combined in order.
+	result, err := collections.ParallelReduce(
+		context.Background(),
+		collections.Range(50),
+		parallelOpts,
+		"",
+		func(acc string, x int) (string, error) {
+			return acc + strconv.Itoa(x%10), nil
+		},
+		func(left string, right string) string {
+			return left + right
+		},
+	)
+	assert.NilErr(t, err)
+	expected := collections.SliceReduce(collections.Range(50), "", func(acc string, x int) string {
+		return acc + strconv.Itoa(x%10)
+	})
+	assert.Equal(t, expected, result)
+}
+
+func TestParallelError(t *testing.T) {
+	errBoom := errors.New("boom")
+	_, err := collections.ParallelMap(
+		context.Background(),
+		collections.Range(100),
+		parallelOpts,
+		func(x int) (int, error) {
+			if x == 42 {
+				return 0, errBoom
+			}
+			return x, nil
+		},
+	)
+	var indexErr *collections.IndexError
+	assert.Assert(t, errors.As(err, &indexErr), "expected an IndexError, got %v", err)
+	assert.Equal(t, 42, indexErr.Index)
+	assert.Assert(t, errors.Is(err, errBoom), "expected %v to wrap errBoom", err)
+}
+
+func TestParallelPanic(t *testing.T) {
+	defer func() {
+		r := recover()
+		p, ok := r.(*collections.PanicError)
+		assert.Assert(t, ok, "expected a *PanicError, got %v", r)
+		assert.Equal(t, any("boom"), p.Value)
+	}()
+	_ = collections.ParallelForEach(
+		context.Background(),
+		collections.Range(100),
+		parallelOpts,
+		func(x int) error {
+			if x == 42 {
+				panic("boom")
+			}
+			return nil
+		},
+	)
+	t.Fatal("expected the panic to reach the caller")
+}
+
+func TestParallelCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := collections.ParallelMap(
+		ctx,
+		collections.Range(100),
+		parallelOpts,
+		func(x int) (int, error) {
+			return x, nil
+		},
+	)
+	assert.Assert(t, errors.Is(err, context.Canceled), "expected context.Canceled, got %v", err)
+}
@@ -0,0 +1,270 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// Each function in this file takes the *rand.Rand it draws from, so tests
+// can pass a seeded source and get reproducible results. A nil *rand.Rand
+// uses the math/rand global source instead. A *rand.Rand is not safe for
+// concurrent use, so don't share one between goroutines.
+
+func randIntn(rng *rand.Rand, n int) int {
+	if rng == nil {
+		return rand.Intn(n)
+	}
+	return rng.Intn(n)
+}
+
+func randFloat64(rng *rand.Rand) float64 {
+	if rng == nil {
+		return rand.Float64()
+	}
+	return rng.Float64()
+}
+
+/*****************
+The direct API for random selection from slices.
+*****************/
+
+// Shuffle the slice in place, with every permutation equally likely.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+// Allocations: None
+func SliceShuffle[T any](sl []T, rng *rand.Rand) {
+	// Fisher-Yates: pick each position's element from the ones not yet placed.
+	for i := len(sl) - 1; i > 0; i-- {
+		j := randIntn(rng, i+1)
+		sl[i], sl[j] = sl[j], sl[i]
+	}
+}
+
+// Create a new slice of k elements chosen at random from the slice without
+// replacement, in random order. Each element is chosen at most once, so
+// duplicates only appear if the slice itself has them. If k is larger than
+// the slice, every element is returned in random order. The original slice
+// is not modified.
+//
+// Time Complexity: O(k)
+// Space Complexity: O(k)
+// Allocations: 1 map, k elements. 1 slice, k elements.
+func SliceSample[T any](sl []T, k int, rng *rand.Rand) []T {
+	if k > len(sl) {
+		k = len(sl)
+	}
+	if k < 0 {
+		k = 0
+	}
+	// Run the first k steps of a Fisher-Yates shuffle over the indices,
+	// keeping track of only the positions that have been swapped.
+	swapped := make(map[int]int, k)
+	result := make([]T, k)
+	for i := 0; i < k; i++ {
+		j := i + randIntn(rng, len(sl)-i)
+		chosen, ok := swapped[j]
+		if !ok {
+			chosen = j
+		}
+		current, ok := swapped[i]
+		if !ok {
+			current = i
+		}
+		swapped[j] = current
+		result[i] = sl[chosen]
+	}
+	return result
+}
+
+// Choose one element of the slice at random. Returns false if the slice is
+// empty.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func SliceChoice[T any](sl []T, rng *rand.Rand) (T, bool) {
+	if len(sl) == 0 {
+		return *new(T), false
+	}
+	return sl[randIntn(rng, len(sl))], true
+}
+
+var ErrInvalidWeights = errors.New("collections: invalid weights")
+
+// WeightedChoice chooses elements at random in proportion to their weights.
+// Create one with SliceWeightedChoice. It is built once in O(n) and each
+// choice after that takes O(1), using Vose's alias method.
+type WeightedChoice[T any] struct {
+	elements []T
+	prob     []float64
+	alias    []int
+	rng      *rand.Rand
+}
+
+// Build a WeightedChoice over the elements of the slice, where each element
+// is chosen with probability weights[i] / sum(weights). The elements are
+// copied, so later changes to the slice are not seen. Returns an error
+// wrapping ErrInvalidWeights if there is not exactly one weight per element,
+// the slice is empty, any weight is negative, NaN or infinite, or the
+// weights sum to zero.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 4 slices, n elements. 2 int slices, resized as necessary.
+func SliceWeightedChoice[T any](sl []T, weights []float64, rng *rand.Rand) (*WeightedChoice[T], error) {
+	if len(sl) != len(weights) {
+		return nil, fmt.Errorf("%w: %d elements but %d weights", ErrInvalidWeights, len(sl), len(weights))
+	}
+	if len(sl) == 0 {
+		return nil, fmt.Errorf("%w: no elements", ErrInvalidWeights)
+	}
+	total := 0.0
+	for i, w := range weights {
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return nil, fmt.Errorf("%w: weight %v at index %d", ErrInvalidWeights, w, i)
+		}
+		total += w
+	}
+	if total == 0 || math.IsInf(total, 0) {
+		return nil, fmt.Errorf("%w: weights sum to %v", ErrInvalidWeights, total)
+	}
+
+	n := len(weights)
+	wc := &WeightedChoice[T]{
+		elements: append([]T(nil), sl...),
+		prob:     make([]float64, n),
+		alias:    make([]int, n),
+		rng:      rng,
+	}
+
+	// Scale the weights so they average 1, then repeatedly pair a column
+	// below 1 with one above 1 to fill it up, moving the excess across.
+	scaled := make([]float64, n)
+	var small, large []int
+	for i, w := range weights {
+		scaled[i] = w * float64(n) / total
+		if scaled[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+	for len(small) > 0 && len(large) > 0 {
+		s, l := small[len(small)-1], large[len(large)-1]
+		small, large = small[:len(small)-1], large[:len(large)-1]
+		wc.prob[s] = scaled[s]
+		wc.alias[s] = l
+		scaled[l] = scaled[l] + scaled[s] - 1
+		if scaled[l] < 1 {
+			small = append(small, l)
+		} else {
+			large = append(large, l)
+		}
+	}
+	// Whatever is left is 1 up to rounding error.
+	for _, i := range large {
+		wc.prob[i] = 1
+	}
+	for _, i := range small {
+		wc.prob[i] = 1
+	}
+	return wc, nil
+}
+
+// Choose an element at random according to the weights.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (wc *WeightedChoice[T]) Choose() T {
+	i := randIntn(wc.rng, len(wc.prob))
+	if randFloat64(wc.rng) < wc.prob[i] {
+		return wc.elements[i]
+	}
+	return wc.elements[wc.alias[i]]
+}
+
+// Reservoir keeps a uniform random sample of up to k elements from a stream
+// of unknown length, so every element added has the same chance of being in
+// the sample. A Reservoir is not safe for concurrent use.
+type Reservoir[T any] struct {
+	sample []T
+	k      int
+	seen   int
+	rng    *rand.Rand
+}
+
+// Initialize a new Reservoir that keeps a sample of up to k elements.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(k)
+// Allocations: 1 slice, k elements.
+func NewReservoir[T any](k int, rng *rand.Rand) *Reservoir[T] {
+	if k < 0 {
+		k = 0
+	}
+	return &Reservoir[T]{
+		sample: make([]T, 0, k),
+		k:      k,
+		rng:    rng,
+	}
+}
+
+// Offer an element to the reservoir. Once k elements have been seen, each
+// new element replaces a random member of the sample with probability
+// k / seen.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (r *Reservoir[T]) Add(el T) {
+	r.seen++
+	if len(r.sample) < r.k {
+		r.sample = append(r.sample, el)
+		return
+	}
+	if j := randIntn(r.rng, r.seen); j < r.k {
+		r.sample[j] = el
+	}
+}
+
+// Get a copy of the current sample. It holds fewer than k elements if fewer
+// than k have been added.
+//
+// Time Complexity: O(k)
+// Space Complexity: O(k)
+// Allocations: 1 slice, k elements.
+func (r *Reservoir[T]) Sample() []T {
+	return append([]T(nil), r.sample...)
+}
+
+// Get the number of elements added to the reservoir so far.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (r *Reservoir[T]) Seen() int {
+	return r.seen
+}
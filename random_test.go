@@ -0,0 +1,172 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/RageCage64/collections-go"
+	"github.com/RageCage64/go-assert"
+)
+
+func newRand() *rand.Rand {
+	return rand.New(rand.NewSource(42))
+}
+
+func TestSliceShuffle(t *testing.T) {
+	sl := collections.Range(20)
+	collections.SliceShuffle(sl, newRand())
+	assert.Assert(t, !sort.IntsAreSorted(sl), "expected %v to be shuffled", sl)
+
+	sorted := append([]int(nil), sl...)
+	sort.Ints(sorted)
+	assert.SliceEqual(t, collections.Range(20), sorted)
+
+	again := collections.Range(20)
+	collections.SliceShuffle(again, newRand())
+	assert.SliceEqual(t, sl, again)
+}
+
+func TestSliceSample(t *testing.T) {
+	sl := collections.Range(100)
+	sample := collections.SliceSample(sl, 10, newRand())
+	assert.Equal(t, 10, len(sample))
+	assert.Equal(t, 10, len(collections.NewSetFromSlice(sample)))
+	for _, el := range sample {
+		assert.Assert(t, el >= 0 && el < 100, "unexpected element %d", el)
+	}
+	assert.SliceEqual(t, collections.Range(100), sl)
+
+	all := collections.SliceSample([]int{1, 2, 3}, 5, newRand())
+	sort.Ints(all)
+	assert.SliceEqual(t, []int{1, 2, 3}, all)
+}
+
+func TestSliceSampleUniform(t *testing.T) {
+	rng := newRand()
+	counts := make([]int, 10)
+	const draws = 20000
+	for i := 0; i < draws; i++ {
+		for _, el := range collections.SliceSample(collections.Range(10), 3, rng) {
+			counts[el]++
+		}
+	}
+	expected := draws * 3 / 10
+	for el, count := range counts {
+		assert.Assert(
+			t,
+			math.Abs(float64(count-expected)) < float64(expected)/10,
+			"element %d chosen %d times, expected about %d", el, count, expected,
+		)
+	}
+}
+
+func TestSliceChoice(t *testing.T) {
+	_, ok := collections.SliceChoice([]int{}, newRand())
+	assert.Assert(t, !ok, "expected no choice from an empty slice")
+
+	el, ok := collections.SliceChoice([]string{"a", "b", "c"}, newRand())
+	assert.Assert(t, ok, "expected a choice")
+	assert.Assert(t, el == "a" || el == "b" || el == "c", "unexpected choice %q", el)
+}
+
+func TestSliceWeightedChoice(t *testing.T) {
+	weights := []float64{1, 0, 3, 6}
+	wc, err := collections.SliceWeightedChoice([]string{"a", "b", "c", "d"}, weights, newRand())
+	assert.NilErr(t, err)
+
+	counts := map[string]int{}
+	const draws = 100000
+	for i := 0; i < draws; i++ {
+		counts[wc.Choose()]++
+	}
+	assert.Equal(t, 0, counts["b"])
+	for i, el := range []string{"a", "c", "d"} {
+		expected := draws * []float64{1, 3, 6}[i] / 10
+		assert.Assert(
+			t,
+			math.Abs(float64(counts[el])-expected) < expected/20,
+			"%s chosen %d times, expected about %v", el, counts[el], expected,
+		)
+	}
+}
+
+func TestSliceWeightedChoiceInvalid(t *testing.T) {
+	testCases := map[string]struct {
+		elements []int
+		weights  []float64
+	}{
+		"mismatched lengths": {[]int{1, 2}, []float64{1}},
+		"empty":              {[]int{}, []float64{}},
+		"negative":           {[]int{1, 2}, []float64{1, -1}},
+		"nan":                {[]int{1}, []float64{math.NaN()}},
+		"infinite":           {[]int{1}, []float64{math.Inf(1)}},
+		"zero sum":           {[]int{1, 2}, []float64{0, 0}},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := collections.SliceWeightedChoice(tc.elements, tc.weights, nil)
+			assert.Assert(t, errors.Is(err, collections.ErrInvalidWeights), "expected ErrInvalidWeights, got %v", err)
+		})
+	}
+}
+
+func TestReservoir(t *testing.T) {
+	r := collections.NewReservoir[int](5, newRand())
+	for i := 0; i < 3; i++ {
+		r.Add(i)
+	}
+	assert.SliceEqual(t, []int{0, 1, 2}, r.Sample())
+
+	for i := 3; i < 1000; i++ {
+		r.Add(i)
+	}
+	assert.Equal(t, 1000, r.Seen())
+	sample := r.Sample()
+	assert.Equal(t, 5, len(sample))
+	assert.Equal(t, 5, len(collections.NewSetFromSlice(sample)))
+}
+
+func TestReservoirUniform(t *testing.T) {
+	rng := newRand()
+	counts := make([]int, 10)
+	const trials = 20000
+	for i := 0; i < trials; i++ {
+		r := collections.NewReservoir[int](2, rng)
+		for el := 0; el < 10; el++ {
+			r.Add(el)
+		}
+		for _, el := range r.Sample() {
+			counts[el]++
+		}
+	}
+	expected := trials * 2 / 10
+	for el, count := range counts {
+		assert.Assert(
+			t,
+			math.Abs(float64(count-expected)) < float64(expected)/10,
+			"element %d kept %d times, expected about %d", el, count, expected,
+		)
+	}
+}
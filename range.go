@@ -0,0 +1,274 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections
+
+import "math"
+
+// IntRange is a lazy arithmetic sequence of integers. It stores only where
+// the sequence starts, its step and how many values it has, so looping over
+// it does not allocate. Create one with LazyRange or LazyRangeInclusive.
+//
+// Iterate with ForEach, or with an iterator:
+//
+//	for it := r.Iterator(); it.Next(); {
+//		fmt.Println(it.Value())
+//	}
+type IntRange[T Integer] struct {
+	start T
+	step  T
+	n     int
+}
+
+// Create a lazy range from start up to but not including stop, counting by
+// step. A negative step counts down, in which case the range is empty unless
+// start > stop. Panics if step is 0, or if the range has more values than
+// fit in an int.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func LazyRange[T Integer](start T, stop T, step T) IntRange[T] {
+	return newIntRange(start, stop, step, false)
+}
+
+// Create a lazy range from start up to and including stop, counting by
+// step. stop is only included if the steps land on it exactly. A negative
+// step counts down. Panics if step is 0, or if the range has more values
+// than fit in an int, such as every value of a 64 bit type.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func LazyRangeInclusive[T Integer](start T, stop T, step T) IntRange[T] {
+	return newIntRange(start, stop, step, true)
+}
+
+func newIntRange[T Integer](start T, stop T, step T, inclusive bool) IntRange[T] {
+	if step == 0 {
+		panic("collections: range step must not be zero")
+	}
+	// Work out the distance and step size as uint64, which can hold the
+	// distance between any two values of any integer type without overflow.
+	var dist, stride uint64
+	if step > 0 {
+		if stop < start || (stop == start && !inclusive) {
+			return IntRange[T]{start: start, step: step}
+		}
+		dist, stride = uint64(stop)-uint64(start), uint64(step)
+	} else {
+		if stop > start || (stop == start && !inclusive) {
+			return IntRange[T]{start: start, step: step}
+		}
+		dist, stride = uint64(start)-uint64(stop), 0-uint64(step)
+	}
+	// The length is one more than the number of whole steps, which could
+	// overflow both uint64 and int, so check the steps before adding one.
+	var steps uint64
+	if inclusive {
+		steps = dist / stride
+	} else {
+		steps = (dist - 1) / stride
+	}
+	if steps >= math.MaxInt {
+		panic("collections: range has too many values for an int length")
+	}
+	return IntRange[T]{start: start, step: step, n: int(steps + 1)}
+}
+
+// Get the number of values in the range.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (r IntRange[T]) Len() int {
+	return r.n
+}
+
+// Get the value at index i of the range. Panics if i is out of bounds.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (r IntRange[T]) At(i int) T {
+	if i < 0 || i >= r.n {
+		panic("collections: range index out of bounds")
+	}
+	// This can overflow T part way through, but the wrapped arithmetic
+	// lands on the right value since the result itself fits in T.
+	return r.start + T(i)*r.step
+}
+
+// Check if a value is one of the values in the range.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (r IntRange[T]) Contains(v T) bool {
+	if r.n == 0 {
+		return false
+	}
+	last := r.At(r.n - 1)
+	var dist, stride uint64
+	if r.step > 0 {
+		if v < r.start || v > last {
+			return false
+		}
+		dist, stride = uint64(v)-uint64(r.start), uint64(r.step)
+	} else {
+		if v > r.start || v < last {
+			return false
+		}
+		dist, stride = uint64(r.start)-uint64(v), 0-uint64(r.step)
+	}
+	return dist%stride == 0
+}
+
+// Run an operation using every value of the range in order.
+//
+// Time Complexity: O(n * m) (where m is the complexity of the operation)
+// Space Complexity: O(1)
+// Allocations: None
+func (r IntRange[T]) ForEach(do UnaryReceiver[T]) {
+	v := r.start
+	for i := 0; i < r.n; i++ {
+		do(v)
+		v += r.step
+	}
+}
+
+// Create a Slice with every value of the range.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements.
+func (r IntRange[T]) Collect() Slice[T] {
+	sl := make(Slice[T], r.n)
+	v := r.start
+	for i := 0; i < r.n; i++ {
+		sl[i] = v
+		v += r.step
+	}
+	return sl
+}
+
+// Create a Stream over the values of the range.
+func (r IntRange[T]) Stream() Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			v := r.start
+			for i := 0; i < r.n; i++ {
+				if !yield(v) {
+					return
+				}
+				v += r.step
+			}
+		},
+		size: r.n,
+	}
+}
+
+// Get an iterator over the values of the range.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (r IntRange[T]) Iterator() RangeIterator[T] {
+	return RangeIterator[T]{r: r, i: -1}
+}
+
+// RangeIterator steps through the values of an IntRange. Call Next before
+// each call to Value, including the first.
+type RangeIterator[T Integer] struct {
+	r IntRange[T]
+	i int
+	v T
+}
+
+// Move to the next value. Returns false when there are no more values.
+func (it *RangeIterator[T]) Next() bool {
+	if it.i+1 >= it.r.n {
+		it.i = it.r.n
+		return false
+	}
+	it.i++
+	if it.i == 0 {
+		it.v = it.r.start
+	} else {
+		it.v += it.r.step
+	}
+	return true
+}
+
+// Get the current value.
+func (it *RangeIterator[T]) Value() T {
+	return it.v
+}
+
+/*****************
+The direct API for creating ranges.
+*****************/
+
+// Produces a Slice of the values from start up to but not including stop,
+// counting by step. A negative step counts down. Panics if step is 0. To
+// loop without allocating, use LazyRange.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements.
+func RangeOf[T Integer](start T, stop T, step T) Slice[T] {
+	return LazyRange(start, stop, step).Collect()
+}
+
+// Produces a Slice of the values from start up to and including stop,
+// counting by step. A negative step counts down. Panics if step is 0. To
+// loop without allocating, use LazyRangeInclusive.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements.
+func RangeOfInclusive[T Integer](start T, stop T, step T) Slice[T] {
+	return LazyRangeInclusive(start, stop, step).Collect()
+}
+
+// Produces a Slice of n evenly spaced values from start to stop, including
+// both ends. Each value is computed from its index rather than by adding a
+// step repeatedly, so rounding error does not build up, and the last value
+// is exactly stop. Returns an empty Slice if n <= 0, and just start if n is
+// 1.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements.
+func LinSpace[T Float](start T, stop T, n int) Slice[T] {
+	if n <= 0 {
+		return Slice[T]{}
+	}
+	sl := make(Slice[T], n)
+	sl[0] = start
+	if n == 1 {
+		return sl
+	}
+	span := float64(stop) - float64(start)
+	for i := 1; i < n-1; i++ {
+		sl[i] = T(float64(start) + span*float64(i)/float64(n-1))
+	}
+	sl[n-1] = stop
+	return sl
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/RageCage64/collections-go"
+	"github.com/RageCage64/go-assert"
+)
+
+func TestRangeOf(t *testing.T) {
+	testCases := map[string]struct {
+		actual   []int
+		expected []int
+	}{
+		"count up":             {collections.RangeOf(2, 10, 3), []int{2, 5, 8}},
+		"count down":           {collections.RangeOf(10, 2, -3), []int{10, 7, 4}},
+		"empty":                {collections.RangeOf(5, 5, 1), []int{}},
+		"wrong direction":      {collections.RangeOf(5, 1, 1), []int{}},
+		"inclusive lands":      {collections.RangeOfInclusive(2, 8, 3), []int{2, 5, 8}},
+		"inclusive misses":     {collections.RangeOfInclusive(2, 9, 3), []int{2, 5, 8}},
+		"inclusive down":       {collections.RangeOfInclusive(3, -3, -3), []int{3, 0, -3}},
+		"inclusive single":     {collections.RangeOfInclusive(4, 4, 1), []int{4}},
+		"inclusive wrong sign": {collections.RangeOfInclusive(4, 5, -1), []int{}},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.SliceEqual(t, tc.expected, tc.actual)
+		})
+	}
+}
+
+func TestRangeOfMatchesRange(t *testing.T) {
+	assert.SliceEqual(t, collections.Range(10), collections.RangeOf(0, 10, 1))
+}
+
+func TestRangeOfTypeBounds(t *testing.T) {
+	sl := collections.RangeOfInclusive[int8](-128, 127, 51)
+	assert.SliceEqual(t, []int8{-128, -77, -26, 25, 76, 127}, sl)
+
+	assert.SliceEqual(t, []uint8{0, 255}, collections.RangeOfInclusive[uint8](0, 255, 255))
+	assert.SliceEqual(t, []uint8{}, collections.RangeOfInclusive[uint8](255, 0, 255))
+
+	r := collections.LazyRangeInclusive[int64](math.MinInt64, math.MaxInt64, math.MaxInt64)
+	assert.Equal(t, 3, r.Len())
+	assert.Equal(t, int64(math.MaxInt64-1), r.At(2))
+}
+
+func TestLazyRange(t *testing.T) {
+	r := collections.LazyRange(10, 0, -2)
+	assert.Equal(t, 5, r.Len())
+	assert.Equal(t, 10, r.At(0))
+	assert.Equal(t, 2, r.At(4))
+	assert.SliceEqual(t, []int{10, 8, 6, 4, 2}, r.Collect())
+
+	assert.Assert(t, r.Contains(6), "expected range to contain 6")
+	assert.Assert(t, !r.Contains(5), "expected range not to contain 5")
+	assert.Assert(t, !r.Contains(0), "expected range not to contain 0")
+	assert.Assert(t, !r.Contains(12), "expected range not to contain 12")
+
+	var fromIterator []int
+	for it := r.Iterator(); it.Next(); {
+		fromIterator = append(fromIterator, it.Value())
+	}
+	assert.SliceEqual(t, r.Collect(), fromIterator)
+
+	var fromForEach []int
+	r.ForEach(func(v int) { fromForEach = append(fromForEach, v) })
+	assert.SliceEqual(t, r.Collect(), fromForEach)
+
+	assert.SliceEqual(t, []int{10, 8}, r.Stream().Take(2).Collect())
+}
+
+func TestLazyRangeZeroStepPanics(t *testing.T) {
+	defer func() {
+		assert.Assert(t, recover() != nil, "expected a zero step to panic")
+	}()
+	collections.LazyRange(0, 10, 0)
+}
+
+func TestLazyRangeLengthBounds(t *testing.T) {
+	if math.MaxInt != math.MaxInt64 {
+		t.Skip("needs a 64 bit int")
+	}
+	assert.Equal(t, math.MaxInt64, collections.LazyRange[int64](0, math.MaxInt64, 1).Len())
+	assert.Equal(t, math.MaxInt64, collections.LazyRangeInclusive[int64](1, math.MaxInt64, 1).Len())
+	assert.Equal(t, math.MaxInt64, collections.LazyRange[uint64](0, math.MaxUint64-1, 2).Len())
+
+	r := collections.LazyRangeInclusive[uint64](0, math.MaxUint64, 4)
+	assert.Equal(t, math.MaxUint64/4+1, r.Len())
+	assert.Equal(t, uint64(math.MaxUint64-3), r.At(r.Len()-1))
+
+	testCases := map[string]func(){
+		"uint64 inclusive":      func() { collections.LazyRangeInclusive[uint64](0, math.MaxUint64, 1) },
+		"uint64 exclusive":      func() { collections.LazyRange[uint64](0, math.MaxUint64, 1) },
+		"uint64 inclusive by 2": func() { collections.LazyRangeInclusive[uint64](0, math.MaxUint64, 2) },
+		"int64 inclusive":       func() { collections.LazyRangeInclusive[int64](0, math.MaxInt64, 1) },
+		"int64 full width":      func() { collections.LazyRangeInclusive[int64](math.MinInt64, math.MaxInt64, 1) },
+		"int64 full width down": func() { collections.LazyRange[int64](math.MaxInt64, math.MinInt64, -1) },
+	}
+	for name, tooLong := range testCases {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				assert.Assert(t, recover() != nil, "expected a range that is too long to panic")
+			}()
+			tooLong()
+		})
+	}
+}
+
+func TestLazyRangeDoesNotAllocate(t *testing.T) {
+	sum := 0
+	allocs := testing.AllocsPerRun(10, func() {
+		r := collections.LazyRange(0, 1000, 1)
+		for it := r.Iterator(); it.Next(); {
+			sum += it.Value()
+		}
+	})
+	assert.Equal(t, 0.0, allocs)
+}
+
+func TestLinSpace(t *testing.T) {
+	sl := collections.LinSpace(0.0, 1.0, 11)
+	assert.Equal(t, 11, len(sl))
+	assert.Equal(t, 0.0, sl[0])
+	assert.Equal(t, 1.0, sl[10])
+	for i := range sl {
+		assertClose(t, float64(i)/10, sl[i])
+	}
+
+	// Adding 0.1 repeatedly drifts away from the exact values.
+	acc := 0.0
+	for i := 0; i < 10; i++ {
+		acc += 0.1
+	}
+	assert.Assert(t, acc != 1.0, "expected repeated addition to drift")
+
+	assert.SliceEqual(t, []float32{2}, collections.LinSpace[float32](2, 5, 1))
+	assert.Equal(t, 0, len(collections.LinSpace(0.0, 1.0, 0)))
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SetDiff is the result of comparing a desired Set against an actual Set.
+// Added contains the elements that are desired but not present, Removed
+// contains the elements that are present but not desired, and Unchanged
+// contains the elements found in both.
+type SetDiff[T comparable] struct {
+	Added     Set[T]
+	Removed   Set[T]
+	Unchanged Set[T]
+}
+
+// Diff compares the desired set against the actual set and reports what
+// would need to be added to and removed from actual for it to equal desired.
+// To compare slices, convert them with NewSetFromSlice first.
+//
+// Time Complexity: O(n + m)
+// Space Complexity: O(n + m)
+// Allocations: 3 sets, n + m elements total
+func Diff[T comparable](desired Set[T], actual Set[T]) SetDiff[T] {
+	diff := SetDiff[T]{
+		Added:     Set[T]{},
+		Removed:   Set[T]{},
+		Unchanged: Set[T]{},
+	}
+	DiffEach(desired, actual, diff.Added.Add, diff.Removed.Add, diff.Unchanged.Add)
+	return diff
+}
+
+// DiffEach compares the desired set against the actual set the same way as
+// Diff, but reports each element to the matching receiver instead of building
+// result sets. Any of the receivers may be nil to skip that kind of change.
+//
+// Time Complexity: O((n + m) * k) (where k is the complexity of the receivers)
+// Space Complexity: O(1)
+// Allocations: None
+func DiffEach[T comparable](
+	desired Set[T],
+	actual Set[T],
+	added UnaryReceiver[T],
+	removed UnaryReceiver[T],
+	unchanged UnaryReceiver[T],
+) {
+	for el := range desired {
+		if actual.Contains(el) {
+			if unchanged != nil {
+				unchanged(el)
+			}
+		} else if added != nil {
+			added(el)
+		}
+	}
+	if removed == nil {
+		return
+	}
+	for el := range actual {
+		if !desired.Contains(el) {
+			removed(el)
+		}
+	}
+}
+
+// Check if the diff contains any additions or removals.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (d SetDiff[T]) Changed() bool {
+	return len(d.Added) > 0 || len(d.Removed) > 0
+}
+
+// String produces a listing of the diff with one element per line, prefixed
+// by "+" for added elements and "-" for removed elements. Unchanged elements
+// are not listed. Elements are sorted by their formatted value so the output
+// is stable between runs.
+//
+// Time Complexity: O(n log n)
+// Space Complexity: O(n)
+// Allocations: 2 string slices, n elements. 1 string builder.
+func (d SetDiff[T]) String() string {
+	var sb strings.Builder
+	writeDiffLines(&sb, "+ ", d.Added)
+	writeDiffLines(&sb, "- ", d.Removed)
+	return sb.String()
+}
+
+func writeDiffLines[T comparable](sb *strings.Builder, prefix string, set Set[T]) {
+	lines := make([]string, 0, len(set))
+	for el := range set {
+		lines = append(lines, fmt.Sprint(el))
+	}
+	sort.Strings(lines)
+	for i := 0; i < len(lines); i++ {
+		sb.WriteString(prefix)
+		sb.WriteString(lines[i])
+		sb.WriteByte('\n')
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections_test
+
+import (
+	"testing"
+
+	"github.com/RageCage64/collections-go"
+	"github.com/RageCage64/go-assert"
+)
+
+func TestDiff(t *testing.T) {
+	desired := collections.NewSet(1, 2, 3, 4)
+	actual := collections.NewSet(3, 4, 5)
+
+	diff := collections.Diff(desired, actual)
+	compareSetAndSlice(t, diff.Added, []int{1, 2})
+	compareSetAndSlice(t, diff.Removed, []int{5})
+	compareSetAndSlice(t, diff.Unchanged, []int{3, 4})
+	assert.Assert(t, diff.Changed(), "expected diff to report changes")
+}
+
+func TestDiffEqualSets(t *testing.T) {
+	diff := collections.Diff(collections.NewSet(1, 2), collections.NewSet(2, 1))
+	assert.Assert(t, !diff.Changed(), "expected no changes, got:\n%s", diff)
+	assert.Equal(t, "", diff.String())
+}
+
+func TestDiffFromSlices(t *testing.T) {
+	desired := collections.Slice[string]{"a", "b", "b"}
+	actual := collections.Slice[string]{"b", "c"}
+
+	diff := collections.Diff(
+		collections.NewSetFromSlice(desired),
+		collections.NewSetFromSlice(actual),
+	)
+	assert.Equal(t, "+ a\n- c\n", diff.String())
+}
+
+func TestDiffEach(t *testing.T) {
+	var added, removed []int
+	collections.DiffEach(
+		collections.NewSet(1, 2),
+		collections.NewSet(2, 3),
+		func(x int) { added = append(added, x) },
+		func(x int) { removed = append(removed, x) },
+		nil,
+	)
+	assert.SliceEqual(t, []int{1}, added)
+	assert.SliceEqual(t, []int{3}, removed)
+}
+
+func TestDiffString(t *testing.T) {
+	diff := collections.Diff(
+		collections.NewSet(10, 2, 3),
+		collections.NewSet(3, 4),
+	)
+	assert.Equal(t, "+ 10\n+ 2\n- 4\n", diff.String())
+}
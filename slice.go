@@ -27,10 +27,24 @@ The arguments for higher-order function algorithms.
 // T and returns a boolean. Used for filtering operations.
 type UnaryPredicate[T any] func(T) bool
 
+// BinaryPredicate is a function that takes two elements of type T and
+// returns a boolean. Used as an equality function for element types that
+// are not comparable.
+type BinaryPredicate[T any] func(a T, b T) bool
+
 // UnaryOperator is a function that takes a single element of type
 // T and returns an element of type T.
 type UnaryOperator[T any] func(T) T
 
+// Mapper is a function that takes a single element of type T and
+// returns an element of type U. Unlike UnaryOperator, the result may be a
+// different type to the input.
+type Mapper[T any, U any] func(T) U
+
+// FilterMapper is a function that takes a single element of type T and
+// returns an element of type U, along with whether the result should be kept.
+type FilterMapper[T any, U any] func(T) (U, bool)
+
 // UnaryReceiver is a function that takes a single element of type
 // T and uses it to do something without returning anything.
 type UnaryReceiver[T any] func(T)
@@ -104,6 +118,53 @@ func (sl AnySlice[T]) ForEach(do UnaryReceiver[T]) {
 	SliceForEach(sl, do)
 }
 
+// Check if any element in the slice satisfies the predicate. Calls
+// SliceContainsFunc.
+func (sl AnySlice[T]) ContainsFunc(pred UnaryPredicate[T]) bool {
+	return SliceContainsFunc(sl, pred)
+}
+
+// Find the index of the first element that satisfies the predicate, or -1.
+// Calls SliceIndexFunc.
+func (sl AnySlice[T]) IndexFunc(pred UnaryPredicate[T]) int {
+	return SliceIndexFunc(sl, pred)
+}
+
+// Find the index of the last element that satisfies the predicate, or -1.
+// Calls SliceLastIndexFunc.
+func (sl AnySlice[T]) LastIndexFunc(pred UnaryPredicate[T]) int {
+	return SliceLastIndexFunc(sl, pred)
+}
+
+// Find the first element that satisfies the predicate. Calls SliceFind.
+func (sl AnySlice[T]) Find(pred UnaryPredicate[T]) (T, bool) {
+	return SliceFind(sl, pred)
+}
+
+// Find the last element that satisfies the predicate. Calls SliceFindLast.
+func (sl AnySlice[T]) FindLast(pred UnaryPredicate[T]) (T, bool) {
+	return SliceFindLast(sl, pred)
+}
+
+// Check if another slice has equal elements in the same order, using an
+// equality function. Calls SliceEqualFunc.
+func (sl AnySlice[T]) EqualFunc(other []T, eq BinaryPredicate[T]) bool {
+	return SliceEqualFunc(sl, other, eq)
+}
+
+// Check if a slice contains each individual element from another slice,
+// using an equality function. Order is not considered. To consider order,
+// use SubsetFunc. Calls SliceContainsEachFunc.
+func (sl AnySlice[T]) ContainsEachFunc(needles []T, eq BinaryPredicate[T]) bool {
+	return SliceContainsEachFunc(sl, needles, eq)
+}
+
+// Check if another slice is a (non-strict) subset of the slice, using an
+// equality function. Calls SliceSubsetFunc.
+func (sl AnySlice[T]) SubsetFunc(sub []T, eq BinaryPredicate[T]) bool {
+	return SliceSubsetFunc(sl, sub, eq)
+}
+
 /*****************
 This section contains the direct API, which is a more traditional Go style
 API.
@@ -111,6 +172,8 @@ API.
 
 // Produces an array with n elements from 0 to n-1. Good for producing a slice
 // to do things n times. Produces a Slice[int], great for calling into ForEach.
+// For other starting points, steps or integer types use RangeOf, and to loop
+// without allocating use LazyRange.
 //
 // Time Complexity: O(n)
 // Space Complexity: O(n)
@@ -159,49 +222,159 @@ func SliceContainsEach[T comparable](haystack []T, needles []T) bool {
 }
 
 // Check if another slice is a subset of the slice. This check is non-strict.
-// For a strict subset, use SliceSubsetStrict.
+// The subset must appear as a contiguous run of elements in the same order.
+// An empty slice is a subset of every slice. For a strict subset, use
+// SliceSubsetStrict.
 //
-// Time Complexity: O(n)
-// Space Complexity: O(1)
-// Allocations: None
+// Time Complexity: O(n + m) (where m = length of sub)
+// Space Complexity: O(m)
+// Allocations: 1 int slice, m elements.
 func SliceSubset[T comparable](sl []T, sub []T) bool {
-	if len(sub) > len(sl) {
-		return false
-	}
-	return subset(sl, sub)
+	return SliceIndexSubslice(sl, sub) >= 0
 }
 
 // Check if another slice is a strict subset of the slice. That is, the other
 // slice is a subset but not equal to the main slice.
 //
-// Time Complexity: O(n)
-// Space Complexity: O(1)
-// Allocations: None
+// Time Complexity: O(n + m) (where m = length of sub)
+// Space Complexity: O(m)
+// Allocations: 1 int slice, m elements.
 func SliceSubsetStrict[T comparable](sl []T, sub []T) bool {
 	// Strict subset means the two slices can't be the same size.
-	if len(sub) > len(sl)-1 {
+	if len(sub) >= len(sl) {
 		return false
 	}
-	return subset(sl, sub)
+	return SliceIndexSubslice(sl, sub) >= 0
+}
+
+// Check if any element in the slice satisfies the predicate.
+//
+// Sometimes known by other names: Any, Some
+//
+// Time Complexity: O(n * m) (where m = complexity of pred)
+// Space Complexity: O(1)
+// Allocations: None
+func SliceContainsFunc[T any](sl []T, pred UnaryPredicate[T]) bool {
+	return SliceIndexFunc(sl, pred) >= 0
 }
 
-func subset[T comparable](sl []T, sub []T) bool {
-	subIdx := 0
+// Find the index of the first element that satisfies the predicate, or -1 if
+// none do.
+//
+// Time Complexity: O(n * m) (where m = complexity of pred)
+// Space Complexity: O(1)
+// Allocations: None
+func SliceIndexFunc[T any](sl []T, pred UnaryPredicate[T]) int {
 	for i := 0; i < len(sl); i++ {
-		if sl[i] == sub[subIdx] {
-			if subIdx == len(sub)-1 {
-				return true
-			}
-			subIdx++
-		} else {
-			subIdx = 0
+		if pred(sl[i]) {
+			return i
 		}
 	}
-	return false
+	return -1
+}
+
+// Find the index of the last element that satisfies the predicate, or -1 if
+// none do.
+//
+// Time Complexity: O(n * m) (where m = complexity of pred)
+// Space Complexity: O(1)
+// Allocations: None
+func SliceLastIndexFunc[T any](sl []T, pred UnaryPredicate[T]) int {
+	for i := len(sl) - 1; i >= 0; i-- {
+		if pred(sl[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Find the first element that satisfies the predicate. Returns false if none
+// do.
+//
+// Time Complexity: O(n * m) (where m = complexity of pred)
+// Space Complexity: O(1)
+// Allocations: None
+func SliceFind[T any](sl []T, pred UnaryPredicate[T]) (T, bool) {
+	if i := SliceIndexFunc(sl, pred); i >= 0 {
+		return sl[i], true
+	}
+	return *new(T), false
+}
+
+// Find the last element that satisfies the predicate. Returns false if none
+// do.
+//
+// Time Complexity: O(n * m) (where m = complexity of pred)
+// Space Complexity: O(1)
+// Allocations: None
+func SliceFindLast[T any](sl []T, pred UnaryPredicate[T]) (T, bool) {
+	if i := SliceLastIndexFunc(sl, pred); i >= 0 {
+		return sl[i], true
+	}
+	return *new(T), false
+}
+
+// Check if two slices have the same length and equal elements in the same
+// order, using an equality function.
+//
+// Time Complexity: O(n * m) (where m = complexity of eq)
+// Space Complexity: O(1)
+// Allocations: None
+func SliceEqualFunc[T any](a []T, b []T, eq BinaryPredicate[T]) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !eq(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Check if a slice contains each individual element of another slice, using
+// an equality function. Order is not considered. To consider order, use
+// SliceSubsetFunc. Unlike SliceContainsEach, this can't use a map, so each
+// needle is searched for separately.
+//
+// Time Complexity: O(n * m * e) (where m = length of needles, e = complexity of eq)
+// Space Complexity: O(1)
+// Allocations: None
+func SliceContainsEachFunc[T any](haystack []T, needles []T, eq BinaryPredicate[T]) bool {
+	for i := 0; i < len(needles); i++ {
+		found := SliceContainsFunc(haystack, func(el T) bool {
+			return eq(el, needles[i])
+		})
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Check if another slice is a subset of the slice, using an equality
+// function. This check is non-strict, and follows the same rules as
+// SliceSubset. The equality function must be reflexive, symmetric and
+// transitive.
+//
+// Time Complexity: O((n + m) * e) (where m = length of sub, e = complexity of eq)
+// Space Complexity: O(m)
+// Allocations: 1 int slice, m elements.
+func SliceSubsetFunc[T any](sl []T, sub []T, eq BinaryPredicate[T]) bool {
+	if len(sub) == 0 {
+		return true
+	}
+	found := false
+	matchSubslice(sl, sub, eq, true, func(int) bool {
+		found = true
+		return false
+	})
+	return found
 }
 
 // Run an operator on every element in the slice, and return a slice that
-// contains the result of every operation.
+// contains the result of every operation. To reuse an existing buffer
+// instead, use SliceMapInto.
 //
 // Sometimes known by other names: Transform, Select
 //
@@ -216,28 +389,81 @@ func SliceMap[T any](sl []T, op UnaryOperator[T]) []T {
 	return result
 }
 
-// Run a predicate on every element in the slice, and return a slice
-// that contains every element for which the predicate was true.
+// Run a mapper on every element in the slice, and return a slice that
+// contains the result of every operation. Unlike SliceMap, the resulting
+// slice may be of a different type.
 //
-// Sometimes known by other names: Where
+// Time Complexity: O(n * m) (where m = complexity of mapper)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements.
+func SliceMapTo[T any, U any](sl []T, mapper Mapper[T, U]) []U {
+	result := make([]U, len(sl))
+	for i := 0; i < len(sl); i++ {
+		result[i] = mapper(sl[i])
+	}
+	return result
+}
+
+// Run a mapper that produces a slice on every element in the slice, and
+// return a single slice that contains every result concatenated in order.
 //
-// Time Complexity: O(n * m) (where m = complexity of predicate)
+// Sometimes known by other names: SelectMany, Bind
+//
+// The result is allocated once at its exact size. Since the total size
+// isn't known until the mapper has run on every element, the slices it
+// returns are held until then, which takes one more allocation no matter
+// how long the input is.
+//
+// Time Complexity: O(n * m + k) (where m = complexity of mapper, k = total
+// number of results)
+// Space Complexity: O(n + k)
+// Allocations: 1 slice of n mapper results. 1 slice, k elements. Along with
+// any allocations made by the mapper.
+func SliceFlatMap[T any, U any](sl []T, mapper Mapper[T, []U]) []U {
+	parts := make([][]U, len(sl))
+	total := 0
+	for i := 0; i < len(sl); i++ {
+		parts[i] = mapper(sl[i])
+		total += len(parts[i])
+	}
+	result := make([]U, total)
+	offset := 0
+	for i := 0; i < len(parts); i++ {
+		offset += copy(result[offset:], parts[i])
+	}
+	return result
+}
+
+// Run a filter mapper on every element in the slice, and return a slice
+// that contains the result of every operation that was kept. This does the
+// job of SliceMapTo and SliceFilter in a single pass.
+//
+// Time Complexity: O(n * m) (where m = complexity of filter mapper)
 // Space Complexity: O(n)
-// Allocations: 2 slice, first with n elements, second is the first slice
-// resized if necessary.
-func SliceFilter[T any](sl []T, pred UnaryPredicate[T]) []T {
-	result := make([]T, len(sl))
+// Allocations: 1 slice, n elements.
+func SliceFilterMap[T any, U any](sl []T, fm FilterMapper[T, U]) []U {
+	result := make([]U, len(sl))
 	resultIdx := 0
 	for i := 0; i < len(sl); i++ {
-		if pred(sl[i]) {
-			result[resultIdx] = sl[i]
+		if mapped, keep := fm(sl[i]); keep {
+			result[resultIdx] = mapped
 			resultIdx++
 		}
 	}
-	if resultIdx < len(result)-1 {
-		result = result[:resultIdx]
-	}
-	return result
+	return result[:resultIdx]
+}
+
+// Run a predicate on every element in the slice, and return a slice
+// that contains every element for which the predicate was true. To reuse an
+// existing buffer instead, use SliceFilterInto or SliceFilterInPlace.
+//
+// Sometimes known by other names: Where
+//
+// Time Complexity: O(n * m) (where m = complexity of predicate)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements.
+func SliceFilter[T any](sl []T, pred UnaryPredicate[T]) []T {
+	return SliceFilterInto(make([]T, 0, len(sl)), sl, pred)
 }
 
 // With a starting accumulator, run the reducer operator with the accumulator
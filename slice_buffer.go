@@ -0,0 +1,167 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections
+
+import (
+	"math/bits"
+	"sync"
+)
+
+/*****************
+The direct API for reusing caller-owned buffers.
+*****************/
+
+// Run a mapper on every element of src and append each result to dst,
+// returning the extended slice. If dst has enough spare capacity, for
+// example dst[:0] from a previous call, nothing is allocated.
+//
+// Time Complexity: O(n * m) (where m = complexity of mapper)
+// Space Complexity: O(n)
+// Allocations: None if cap(dst) - len(dst) >= len(src), otherwise dst is
+// resized as necessary.
+func SliceMapInto[T any, U any](dst []U, src []T, mapper Mapper[T, U]) []U {
+	for i := 0; i < len(src); i++ {
+		dst = append(dst, mapper(src[i]))
+	}
+	return dst
+}
+
+// Run a predicate on every element of src and append each element for
+// which the predicate was true to dst, returning the extended slice. If dst
+// has enough spare capacity, nothing is allocated.
+//
+// Time Complexity: O(n * m) (where m = complexity of predicate)
+// Space Complexity: O(n)
+// Allocations: None if cap(dst) - len(dst) >= len(src), otherwise dst is
+// resized as necessary.
+func SliceFilterInto[T any](dst []T, src []T, pred UnaryPredicate[T]) []T {
+	for i := 0; i < len(src); i++ {
+		if pred(src[i]) {
+			dst = append(dst, src[i])
+		}
+	}
+	return dst
+}
+
+// Keep only the elements for which the predicate is true, in place. Returns
+// the shortened slice, which shares memory with the original. The elements
+// beyond the new length are set to the zero value so anything they referred
+// to can be garbage collected.
+//
+// Time Complexity: O(n * m) (where m = complexity of predicate)
+// Space Complexity: O(1)
+// Allocations: None
+func SliceFilterInPlace[T any](sl []T, pred UnaryPredicate[T]) []T {
+	k := 0
+	for i := 0; i < len(sl); i++ {
+		if pred(sl[i]) {
+			sl[k] = sl[i]
+			k++
+		}
+	}
+	var zero T
+	for i := k; i < len(sl); i++ {
+		sl[i] = zero
+	}
+	return sl[:k]
+}
+
+const (
+	slicePoolMinShift = 4
+	slicePoolMaxShift = 20
+	slicePoolClasses  = slicePoolMaxShift - slicePoolMinShift + 1
+)
+
+// SlicePool is a typed pool of scratch slices, built on sync.Pool. Slices
+// are grouped into size classes by powers of two capacity from 16 to 1<<20,
+// so a request is always served by a slice that is big enough without being
+// wastefully large. Requests beyond the largest class are allocated directly
+// and not pooled.
+//
+// The zero value is ready to use. A SlicePool is safe for concurrent use, and
+// must not be copied after first use.
+type SlicePool[T any] struct {
+	classes [slicePoolClasses]sync.Pool
+	// headers holds empty *[]T boxes, so storing a slice in a class pool
+	// does not need a new allocation each time.
+	headers sync.Pool
+}
+
+// Get a slice with length 0 and capacity of at least n.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None if a pooled slice is available, otherwise 1 slice with
+// capacity n rounded up to a power of two.
+func (p *SlicePool[T]) Get(n int) []T {
+	class, ok := slicePoolClassFor(n)
+	if !ok {
+		return make([]T, 0, n)
+	}
+	if box, _ := p.classes[class].Get().(*[]T); box != nil {
+		sl := *box
+		*box = nil
+		p.headers.Put(box)
+		return sl
+	}
+	return make([]T, 0, 1<<(class+slicePoolMinShift))
+}
+
+// Return a slice to the pool so a later Get can reuse it. The elements up to
+// len(sl) are set to the zero value so anything they referred to can be
+// garbage collected. The caller must not use sl after calling Put. Slices
+// with capacity below the smallest class are dropped.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+// Allocations: None once the pool is warm.
+func (p *SlicePool[T]) Put(sl []T) {
+	var zero T
+	for i := 0; i < len(sl); i++ {
+		sl[i] = zero
+	}
+	// Round down, so every slice in a class has at least the class size.
+	shift := bits.Len(uint(cap(sl))) - 1
+	if shift < slicePoolMinShift {
+		return
+	}
+	if shift > slicePoolMaxShift {
+		shift = slicePoolMaxShift
+	}
+	box, _ := p.headers.Get().(*[]T)
+	if box == nil {
+		box = new([]T)
+	}
+	*box = sl[:0]
+	p.classes[shift-slicePoolMinShift].Put(box)
+}
+
+// slicePoolClassFor returns the smallest class whose slices can hold n
+// elements, or false if n is beyond the largest class.
+func slicePoolClassFor(n int) (int, bool) {
+	shift := slicePoolMinShift
+	if n > 1<<slicePoolMinShift {
+		shift = bits.Len(uint(n - 1))
+	}
+	if shift > slicePoolMaxShift {
+		return 0, false
+	}
+	return shift - slicePoolMinShift, true
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections_test
+
+import (
+	"testing"
+
+	"github.com/RageCage64/collections-go"
+	"github.com/RageCage64/go-assert"
+)
+
+func TestSliceMapInto(t *testing.T) {
+	dst := []int{100}
+	dst = collections.SliceMapInto(dst, []int{1, 2, 3}, double)
+	assert.SliceEqual(t, []int{100, 2, 4, 6}, dst)
+
+	strs := collections.SliceMapInto(nil, []int{1, 2}, func(x int) string {
+		return string(rune('a' + x))
+	})
+	assert.SliceEqual(t, []string{"b", "c"}, strs)
+}
+
+func TestSliceFilterInto(t *testing.T) {
+	buf := make([]int, 0, 8)
+	result := collections.SliceFilterInto(buf, collections.Range(8), isEven)
+	assert.SliceEqual(t, []int{0, 2, 4, 6}, result)
+	assert.Equal(t, 8, cap(result))
+
+	result = collections.SliceFilterInto(result[:0], []int{1, 3, 4}, isEven)
+	assert.SliceEqual(t, []int{4}, result)
+}
+
+func TestSliceFilterInPlace(t *testing.T) {
+	sl := []*int{new(int), nil, new(int), nil}
+	result := collections.SliceFilterInPlace(sl, func(p *int) bool { return p != nil })
+	assert.Equal(t, 2, len(result))
+	assert.Assert(t, result[0] != nil && result[1] != nil, "expected only non-nil elements")
+	assert.Assert(t, sl[2] == nil && sl[3] == nil, "expected the tail to be cleared")
+}
+
+func TestSliceIntoDoesNotAllocate(t *testing.T) {
+	src := collections.Range(1000)
+	dst := make([]int, 0, len(src))
+	allocs := testing.AllocsPerRun(10, func() {
+		dst = collections.SliceMapInto(dst[:0], src, double)
+		dst = collections.SliceFilterInto(dst[:0], src, isEven)
+		dst = collections.SliceFilterInPlace(dst, isEven)
+	})
+	assert.Equal(t, 0.0, allocs)
+}
+
+func TestSlicePool(t *testing.T) {
+	var pool collections.SlicePool[int]
+
+	sl := pool.Get(5)
+	assert.Equal(t, 0, len(sl))
+	assert.Equal(t, 16, cap(sl))
+
+	sl = pool.Get(100)
+	assert.Equal(t, 128, cap(sl))
+	sl = append(sl, 1, 2, 3)
+	pool.Put(sl)
+	assert.Equal(t, 0, sl[0])
+
+	big := pool.Get(1<<20 + 1)
+	assert.Equal(t, 1<<20+1, cap(big))
+
+	// Slices smaller than the smallest class are dropped rather than pooled.
+	pool.Put(make([]int, 0, 4))
+}
+
+func TestSlicePoolReuses(t *testing.T) {
+	var pool collections.SlicePool[int]
+	pool.Put(pool.Get(64))
+	allocs := testing.AllocsPerRun(100, func() {
+		sl := pool.Get(64)
+		sl = append(sl, 1)
+		pool.Put(sl)
+	})
+	// sync.Pool may drop items at any GC, so allow for the odd refill.
+	assert.Assert(t, allocs < 1, "expected the pool to be reused, got %v allocations per run", allocs)
+}
+
+func BenchmarkSliceMap(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = collections.SliceMap(benchInput, double)
+	}
+}
+
+func BenchmarkSliceMapInto(b *testing.B) {
+	dst := make([]int, 0, len(benchInput))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dst = collections.SliceMapInto(dst[:0], benchInput, double)
+	}
+	assertNoAllocs(b, func() { dst = collections.SliceMapInto(dst[:0], benchInput, double) })
+}
+
+func BenchmarkSliceFilter(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = collections.SliceFilter(benchInput, isEven)
+	}
+}
+
+func BenchmarkSliceFilterInto(b *testing.B) {
+	dst := make([]int, 0, len(benchInput))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dst = collections.SliceFilterInto(dst[:0], benchInput, isEven)
+	}
+	assertNoAllocs(b, func() { dst = collections.SliceFilterInto(dst[:0], benchInput, isEven) })
+}
+
+func BenchmarkSliceFilterInPlace(b *testing.B) {
+	buf := make([]int, len(benchInput))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(buf, benchInput)
+		_ = collections.SliceFilterInPlace(buf, isEven)
+	}
+	assertNoAllocs(b, func() {
+		copy(buf, benchInput)
+		_ = collections.SliceFilterInPlace(buf, isEven)
+	})
+}
+
+func assertNoAllocs(b *testing.B, f func()) {
+	b.Helper()
+	if allocs := testing.AllocsPerRun(10, f); allocs != 0 {
+		b.Fatalf("expected no allocations, got %v per run", allocs)
+	}
+}
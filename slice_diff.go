@@ -0,0 +1,330 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EditOp is the kind of change an EditHunk describes.
+type EditOp int
+
+const (
+	// EditEqual means the elements are in both slices.
+	EditEqual EditOp = iota
+	// EditInsert means the elements are only in the second slice.
+	EditInsert
+	// EditDelete means the elements are only in the first slice.
+	EditDelete
+)
+
+// String returns "Equal", "Insert" or "Delete".
+func (op EditOp) String() string {
+	switch op {
+	case EditEqual:
+		return "Equal"
+	case EditInsert:
+		return "Insert"
+	default:
+		return "Delete"
+	}
+}
+
+// EditHunk is a run of consecutive elements that share the same EditOp.
+// AIndex and BIndex are the positions in the first and second slice where
+// the hunk starts. Elements come from the first slice for Equal and Delete
+// hunks, and from the second slice for Insert hunks.
+type EditHunk[T any] struct {
+	Op       EditOp
+	AIndex   int
+	BIndex   int
+	Elements []T
+}
+
+// EditScript is the list of hunks that turns one slice into another.
+type EditScript[T any] []EditHunk[T]
+
+// Check if the script contains no inserts or deletes, which means the two
+// slices were equal.
+func (es EditScript[T]) Equal() bool {
+	for i := 0; i < len(es); i++ {
+		if es[i].Op != EditEqual {
+			return false
+		}
+	}
+	return true
+}
+
+// String formats the script as a unified diff with 3 elements of context.
+func (es EditScript[T]) String() string {
+	return es.Unified(3)
+}
+
+// Unified formats the script in the style of a unified diff, with one
+// element per line prefixed by "-" for deletes, "+" for inserts and " " for
+// context. Changes are grouped into sections with @@ headers giving the
+// 1-based position and length of the section in each slice, and context
+// controls how many equal elements are shown around each change. Returns an
+// empty string when the slices were equal.
+//
+// Time Complexity: O(n + m)
+// Space Complexity: O(n + m)
+// Allocations: 1 slice, n + m lines. 1 string builder.
+func (es EditScript[T]) Unified(context int) string {
+	type line struct {
+		op   EditOp
+		a, b int
+		text string
+	}
+	var lines []line
+	for _, hunk := range es {
+		for i, el := range hunk.Elements {
+			a, b := hunk.AIndex, hunk.BIndex
+			switch hunk.Op {
+			case EditEqual:
+				a, b = a+i, b+i
+			case EditDelete:
+				a += i
+			case EditInsert:
+				b += i
+			}
+			lines = append(lines, line{hunk.Op, a, b, fmt.Sprint(el)})
+		}
+	}
+
+	var sb strings.Builder
+	for start := 0; start < len(lines); {
+		// Find the next change, then extend the section until there is a
+		// gap of more than 2 * context equal lines to the change after.
+		first := start
+		for first < len(lines) && lines[first].op == EditEqual {
+			first++
+		}
+		if first == len(lines) {
+			break
+		}
+		last := first
+		for i := first; i < len(lines); i++ {
+			if lines[i].op != EditEqual {
+				last = i
+			} else if i-last > 2*context {
+				break
+			}
+		}
+		lo := first - context
+		if lo < start {
+			lo = start
+		}
+		hi := last + context + 1
+		if hi > len(lines) {
+			hi = len(lines)
+		}
+
+		aStart, bStart, aLen, bLen := lines[lo].a, lines[lo].b, 0, 0
+		for i := lo; i < hi; i++ {
+			if lines[i].op != EditInsert {
+				aLen++
+			}
+			if lines[i].op != EditDelete {
+				bLen++
+			}
+		}
+		// Like diff, an empty range is given by the line before it.
+		if aLen > 0 {
+			aStart++
+		}
+		if bLen > 0 {
+			bStart++
+		}
+		fmt.Fprintf(&sb, "@@ -%d,%d +%d,%d @@\n", aStart, aLen, bStart, bLen)
+		for i := lo; i < hi; i++ {
+			switch lines[i].op {
+			case EditEqual:
+				sb.WriteByte(' ')
+			case EditDelete:
+				sb.WriteByte('-')
+			case EditInsert:
+				sb.WriteByte('+')
+			}
+			sb.WriteString(lines[i].text)
+			sb.WriteByte('\n')
+		}
+		start = hi
+	}
+	return sb.String()
+}
+
+/*****************
+The direct API for comparing slices.
+*****************/
+
+// Compute the shortest edit script that turns slice a into slice b, using
+// Myers' O(ND) difference algorithm.
+//
+// Time Complexity: O((n + m) * d) (where d = number of inserts and deletes)
+// Space Complexity: O((n + m) * d)
+// Allocations: 1 int slice per edit distance step. 1 script, resized as necessary.
+func SliceDiff[T comparable](a []T, b []T) EditScript[T] {
+	return SliceDiffFunc(a, b, equal[T])
+}
+
+// Compute the shortest edit script that turns slice a into slice b, using
+// Myers' O(ND) difference algorithm with a custom equality function. This
+// works for element types that are not comparable, such as those held in an
+// AnySlice.
+//
+// Time Complexity: O((n + m) * d * e) (where d = number of inserts and
+// deletes, e = complexity of eq)
+// Space Complexity: O((n + m) * d)
+// Allocations: 1 int slice per edit distance step. 1 script, resized as necessary.
+func SliceDiffFunc[T any](a []T, b []T, eq BinaryPredicate[T]) EditScript[T] {
+	n, m := len(a), len(b)
+	max := n + m
+	offset := max + 1
+	v := make([]int, 2*max+3)
+	var trace [][]int
+
+	found := false
+	for d := 0; d <= max && !found; d++ {
+		trace = append(trace, append([]int(nil), v...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && eq(a[x], b[y]) {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				found = true
+				break
+			}
+		}
+	}
+
+	// Walk back through the trace from the end of both slices, recording
+	// one edit per element in reverse.
+	type edit struct {
+		op   EditOp
+		a, b int
+	}
+	var edits []edit
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		vd := trace[d]
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && vd[offset+k-1] < vd[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := vd[offset+prevK]
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			x--
+			y--
+			edits = append(edits, edit{EditEqual, x, y})
+		}
+		if d > 0 {
+			if x == prevX {
+				edits = append(edits, edit{EditInsert, x, prevY})
+			} else {
+				edits = append(edits, edit{EditDelete, prevX, y})
+			}
+		}
+		x, y = prevX, prevY
+	}
+
+	var script EditScript[T]
+	for i := len(edits) - 1; i >= 0; i-- {
+		e := edits[i]
+		var el T
+		if e.op == EditInsert {
+			el = b[e.b]
+		} else {
+			el = a[e.a]
+		}
+		last := len(script) - 1
+		if last >= 0 && script[last].Op == e.op {
+			script[last].Elements = append(script[last].Elements, el)
+			continue
+		}
+		script = append(script, EditHunk[T]{Op: e.op, AIndex: e.a, BIndex: e.b, Elements: []T{el}})
+	}
+	return script
+}
+
+// Compute the Levenshtein distance between two slices, which is the minimum
+// number of single element inserts, deletes and substitutions needed to turn
+// one into the other.
+//
+// Time Complexity: O(n * m)
+// Space Complexity: O(min(n, m))
+// Allocations: 1 int slice, min(n, m) + 1 elements.
+func SliceLevenshtein[T comparable](a []T, b []T) int {
+	return SliceLevenshteinFunc(a, b, equal[T])
+}
+
+// Compute the Levenshtein distance between two slices using a custom
+// equality function.
+//
+// Time Complexity: O(n * m * e) (where e = complexity of eq)
+// Space Complexity: O(min(n, m))
+// Allocations: 1 int slice, min(n, m) + 1 elements.
+func SliceLevenshteinFunc[T any](a []T, b []T, eq BinaryPredicate[T]) int {
+	if len(b) > len(a) {
+		a, b = b, a
+		orig := eq
+		eq = func(x T, y T) bool { return orig(y, x) }
+	}
+	// row[j] holds the distance between the current prefix of a and b[:j].
+	row := make([]int, len(b)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		diag := row[0]
+		row[0] = i
+		for j := 1; j <= len(b); j++ {
+			above := row[j]
+			cost := 1
+			if eq(a[i-1], b[j-1]) {
+				cost = 0
+			}
+			best := diag + cost
+			if above+1 < best {
+				best = above + 1
+			}
+			if row[j-1]+1 < best {
+				best = row[j-1] + 1
+			}
+			row[j] = best
+			diag = above
+		}
+	}
+	return row[len(b)]
+}
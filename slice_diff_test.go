@@ -0,0 +1,150 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/RageCage64/collections-go"
+	"github.com/RageCage64/go-assert"
+)
+
+// applyScript rebuilds both slices from an edit script.
+func applyScript[T any](script collections.EditScript[T]) (a []T, b []T) {
+	for _, hunk := range script {
+		if hunk.Op != collections.EditInsert {
+			a = append(a, hunk.Elements...)
+		}
+		if hunk.Op != collections.EditDelete {
+			b = append(b, hunk.Elements...)
+		}
+	}
+	return a, b
+}
+
+func countEdits[T any](script collections.EditScript[T]) int {
+	n := 0
+	for _, hunk := range script {
+		if hunk.Op != collections.EditEqual {
+			n += len(hunk.Elements)
+		}
+	}
+	return n
+}
+
+// lcsLength is the brute-force oracle for the length of the longest common
+// subsequence, which determines the minimum number of inserts and deletes.
+func lcsLength(a []int, b []int) int {
+	table := make([][]int, len(a)+1)
+	for i := range table {
+		table[i] = make([]int, len(b)+1)
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				table[i][j] = table[i-1][j-1] + 1
+			} else if table[i-1][j] > table[i][j-1] {
+				table[i][j] = table[i-1][j]
+			} else {
+				table[i][j] = table[i][j-1]
+			}
+		}
+	}
+	return table[len(a)][len(b)]
+}
+
+func TestSliceDiff(t *testing.T) {
+	a := []string{"a", "b", "c", "a", "b", "b", "a"}
+	b := []string{"c", "b", "a", "b", "a", "c"}
+	script := collections.SliceDiff(a, b)
+	gotA, gotB := applyScript(script)
+	assert.SliceEqual(t, a, gotA)
+	assert.SliceEqual(t, b, gotB)
+	assert.Equal(t, 5, countEdits(script))
+	assert.Assert(t, !script.Equal(), "expected script to have changes")
+}
+
+func TestSliceDiffEqual(t *testing.T) {
+	script := collections.SliceDiff([]int{1, 2, 3}, []int{1, 2, 3})
+	assert.Assert(t, script.Equal(), "expected no changes, got:\n%s", script)
+	assert.Equal(t, "", script.String())
+	assert.Equal(t, 0, len(collections.SliceDiff([]int{}, []int{})))
+}
+
+func TestSliceDiffRandomized(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	randSlice := func() []int {
+		sl := make([]int, rng.Intn(12))
+		for i := range sl {
+			sl[i] = rng.Intn(4)
+		}
+		return sl
+	}
+	for i := 0; i < 500; i++ {
+		a, b := randSlice(), randSlice()
+		script := collections.SliceDiff(a, b)
+		gotA, gotB := applyScript(script)
+		assert.SliceEqual(t, a, gotA)
+		assert.SliceEqual(t, b, gotB)
+		assert.Equal(t, len(a)+len(b)-2*lcsLength(a, b), countEdits(script))
+	}
+}
+
+func TestSliceDiffFunc(t *testing.T) {
+	a := collections.AnySlice[[]int]{{1}, {2}, {3}}
+	b := collections.AnySlice[[]int]{{1}, {3}, {4}}
+	script := collections.SliceDiffFunc(a, b, func(x []int, y []int) bool {
+		return x[0] == y[0]
+	})
+	assert.Equal(t, 2, countEdits(script))
+}
+
+func TestSliceDiffUnified(t *testing.T) {
+	a := []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"}
+	b := []string{"1", "two", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13"}
+	expected := "" +
+		"@@ -1,3 +1,3 @@\n" +
+		" 1\n" +
+		"-2\n" +
+		"+two\n" +
+		" 3\n" +
+		"@@ -12,1 +12,2 @@\n" +
+		" 12\n" +
+		"+13\n"
+	assert.Equal(t, expected, collections.SliceDiff(a, b).Unified(1))
+}
+
+func TestSliceLevenshtein(t *testing.T) {
+	testCases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"flaw", "lawn", 2},
+		{"same", "same", 0},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, collections.SliceLevenshtein([]byte(tc.a), []byte(tc.b)))
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections
+
+import (
+	"errors"
+	"fmt"
+)
+
+/*****************
+The fallible arguments for higher-order function algorithms.
+*****************/
+
+// UnaryOperatorErr is a UnaryOperator that can fail.
+type UnaryOperatorErr[T any] func(T) (T, error)
+
+// UnaryPredicateErr is a UnaryPredicate that can fail.
+type UnaryPredicateErr[T any] func(T) (bool, error)
+
+// UnaryReceiverErr is a UnaryReceiver that can fail.
+type UnaryReceiverErr[T any] func(T) error
+
+// ReducerErr is a Reducer that can fail.
+type ReducerErr[Acc any, T any] func(accumulator Acc, current T) (Acc, error)
+
+// IndexError is returned by the fallible slice functions to report the
+// index of the element whose callback failed, along with the error the
+// callback returned.
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("collections: element %d: %v", e.Index, e.Err)
+}
+
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+/*****************
+The fallible direct API. Each function comes in two forms: one that stops at
+the first error, and an All form that runs every element and returns an
+errors.Join of every failure.
+*****************/
+
+// Run an operator on every element in the slice, and return a slice that
+// contains the result of every operation. Stops at the first error and
+// returns it as an *IndexError, along with a nil slice.
+//
+// Time Complexity: O(n * m) (where m = complexity of operator)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements.
+func SliceMapErr[T any](sl []T, op UnaryOperatorErr[T]) ([]T, error) {
+	result := make([]T, len(sl))
+	for i := 0; i < len(sl); i++ {
+		mapped, err := op(sl[i])
+		if err != nil {
+			return nil, &IndexError{Index: i, Err: err}
+		}
+		result[i] = mapped
+	}
+	return result, nil
+}
+
+// Run an operator on every element in the slice, and return a slice that
+// contains the result of every operation. Elements whose operation failed
+// are left as the zero value, and every failure is returned as an
+// *IndexError inside an errors.Join.
+//
+// Time Complexity: O(n * m) (where m = complexity of operator)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements. 1 error slice, resized as necessary.
+func SliceMapErrAll[T any](sl []T, op UnaryOperatorErr[T]) ([]T, error) {
+	result := make([]T, len(sl))
+	var errs []error
+	for i := 0; i < len(sl); i++ {
+		mapped, err := op(sl[i])
+		if err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+			continue
+		}
+		result[i] = mapped
+	}
+	return result, errors.Join(errs...)
+}
+
+// Run a predicate on every element in the slice, and return a slice
+// that contains every element for which the predicate was true. Stops at
+// the first error and returns it as an *IndexError, along with a nil slice.
+//
+// Time Complexity: O(n * m) (where m = complexity of predicate)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements.
+func SliceFilterErr[T any](sl []T, pred UnaryPredicateErr[T]) ([]T, error) {
+	result := make([]T, len(sl))
+	resultIdx := 0
+	for i := 0; i < len(sl); i++ {
+		keep, err := pred(sl[i])
+		if err != nil {
+			return nil, &IndexError{Index: i, Err: err}
+		}
+		if keep {
+			result[resultIdx] = sl[i]
+			resultIdx++
+		}
+	}
+	return result[:resultIdx], nil
+}
+
+// Run a predicate on every element in the slice, and return a slice
+// that contains every element for which the predicate was true. Elements
+// whose predicate failed are left out, and every failure is returned as an
+// *IndexError inside an errors.Join.
+//
+// Time Complexity: O(n * m) (where m = complexity of predicate)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements. 1 error slice, resized as necessary.
+func SliceFilterErrAll[T any](sl []T, pred UnaryPredicateErr[T]) ([]T, error) {
+	result := make([]T, len(sl))
+	resultIdx := 0
+	var errs []error
+	for i := 0; i < len(sl); i++ {
+		keep, err := pred(sl[i])
+		if err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+			continue
+		}
+		if keep {
+			result[resultIdx] = sl[i]
+			resultIdx++
+		}
+	}
+	return result[:resultIdx], errors.Join(errs...)
+}
+
+// With a starting accumulator, run the reducer with the accumulator and each
+// element of the slice. Stops at the first error and returns it as an
+// *IndexError, along with the accumulator as it was before the failure.
+//
+// Time Complexity: O(n * m) (where m = complexity of reducer)
+// Space Complexity: O(1)
+// Allocations: None
+func SliceReduceErr[Acc any, T any](
+	sl []T,
+	accumulator Acc,
+	reducer ReducerErr[Acc, T],
+) (Acc, error) {
+	for i := 0; i < len(sl); i++ {
+		next, err := reducer(accumulator, sl[i])
+		if err != nil {
+			return accumulator, &IndexError{Index: i, Err: err}
+		}
+		accumulator = next
+	}
+	return accumulator, nil
+}
+
+// With a starting accumulator, run the reducer with the accumulator and each
+// element of the slice. Elements whose reducer failed are skipped, leaving
+// the accumulator unchanged, and every failure is returned as an *IndexError
+// inside an errors.Join.
+//
+// Time Complexity: O(n * m) (where m = complexity of reducer)
+// Space Complexity: O(1)
+// Allocations: 1 error slice, resized as necessary.
+func SliceReduceErrAll[Acc any, T any](
+	sl []T,
+	accumulator Acc,
+	reducer ReducerErr[Acc, T],
+) (Acc, error) {
+	var errs []error
+	for i := 0; i < len(sl); i++ {
+		next, err := reducer(accumulator, sl[i])
+		if err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+			continue
+		}
+		accumulator = next
+	}
+	return accumulator, errors.Join(errs...)
+}
+
+// Run an operation using each element of the slice one at a time. Stops at
+// the first error and returns it as an *IndexError.
+//
+// Time Complexity: O(n * m) (where m is the complexity of the operation)
+// Space Complexity: O(1)
+// Allocations: None
+func SliceForEachErr[T any](sl []T, do UnaryReceiverErr[T]) error {
+	for i := 0; i < len(sl); i++ {
+		if err := do(sl[i]); err != nil {
+			return &IndexError{Index: i, Err: err}
+		}
+	}
+	return nil
+}
+
+// Run an operation using each element of the slice one at a time. Every
+// failure is returned as an *IndexError inside an errors.Join.
+//
+// Time Complexity: O(n * m) (where m is the complexity of the operation)
+// Space Complexity: O(1)
+// Allocations: 1 error slice, resized as necessary.
+func SliceForEachErrAll[T any](sl []T, do UnaryReceiverErr[T]) error {
+	var errs []error
+	for i := 0; i < len(sl); i++ {
+		if err := do(sl[i]); err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/RageCage64/collections-go"
+	"github.com/RageCage64/go-assert"
+)
+
+var errOdd = errors.New("odd")
+
+func failOnOdd(x int) (int, error) {
+	if x%2 == 1 {
+		return 0, errOdd
+	}
+	return x * 10, nil
+}
+
+func assertIndexError(t *testing.T, err error, index int) {
+	t.Helper()
+
+	var indexErr *collections.IndexError
+	assert.Assert(t, errors.As(err, &indexErr), "expected an IndexError, got %v", err)
+	assert.Equal(t, index, indexErr.Index)
+	assert.Assert(t, errors.Is(err, errOdd), "expected %v to wrap errOdd", err)
+}
+
+func TestSliceMapErr(t *testing.T) {
+	result, err := collections.SliceMapErr([]int{2, 4}, failOnOdd)
+	assert.NilErr(t, err)
+	assert.SliceEqual(t, []int{20, 40}, result)
+
+	result, err = collections.SliceMapErr([]int{2, 3, 5}, failOnOdd)
+	assertIndexError(t, err, 1)
+	assert.Assert(t, result == nil, "expected nil result, got %v", result)
+}
+
+func TestSliceMapErrAll(t *testing.T) {
+	result, err := collections.SliceMapErrAll([]int{2, 3, 4, 5}, failOnOdd)
+	assert.SliceEqual(t, []int{20, 0, 40, 0}, result)
+	assert.Equal(t, "collections: element 1: odd\ncollections: element 3: odd", err.Error())
+}
+
+func TestSliceFilterErr(t *testing.T) {
+	parses := func(s string) (bool, error) {
+		n, err := strconv.Atoi(s)
+		return n > 1, err
+	}
+	result, err := collections.SliceFilterErr([]string{"1", "2", "3"}, parses)
+	assert.NilErr(t, err)
+	assert.SliceEqual(t, []string{"2", "3"}, result)
+
+	_, err = collections.SliceFilterErr([]string{"1", "x"}, parses)
+	var indexErr *collections.IndexError
+	assert.Assert(t, errors.As(err, &indexErr), "expected an IndexError, got %v", err)
+	assert.Equal(t, 1, indexErr.Index)
+
+	result, err = collections.SliceFilterErrAll([]string{"x", "2", "y", "3"}, parses)
+	assert.SliceEqual(t, []string{"2", "3"}, result)
+	assert.Assert(t, err != nil, "expected errors for x and y")
+}
+
+func TestSliceReduceErr(t *testing.T) {
+	sum := func(acc int, x int) (int, error) {
+		if _, err := failOnOdd(x); err != nil {
+			return acc, err
+		}
+		return acc + x, nil
+	}
+	result, err := collections.SliceReduceErr([]int{2, 4, 5, 6}, 0, sum)
+	assertIndexError(t, err, 2)
+	assert.Equal(t, 6, result)
+
+	result, err = collections.SliceReduceErrAll([]int{2, 4, 5, 6}, 0, sum)
+	assertIndexError(t, err, 2)
+	assert.Equal(t, 12, result)
+}
+
+func TestSliceForEachErr(t *testing.T) {
+	do := func(x int) error {
+		_, err := failOnOdd(x)
+		return err
+	}
+	assert.NilErr(t, collections.SliceForEachErr([]int{2, 4}, do))
+	assertIndexError(t, collections.SliceForEachErr([]int{2, 3, 5}, do), 1)
+	assert.NilErr(t, collections.SliceForEachErrAll([]int{}, do))
+	assertIndexError(t, collections.SliceForEachErrAll([]int{3, 5}, do), 0)
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections
+
+/*****************
+The direct API for reshaping slices.
+*****************/
+
+// Group the elements of a slice by a key computed from each element. The
+// elements in each group keep their original order.
+//
+// Time Complexity: O(n * m) (where m = complexity of key)
+// Space Complexity: O(n)
+// Allocations: 1 map, k keys. k slices, resized as necessary (where k is the
+// number of distinct keys).
+func SliceGroupBy[T any, K comparable](sl []T, key Mapper[T, K]) map[K][]T {
+	groups := map[K][]T{}
+	for i := 0; i < len(sl); i++ {
+		k := key(sl[i])
+		groups[k] = append(groups[k], sl[i])
+	}
+	return groups
+}
+
+// Split a slice into the elements for which the predicate is true and the
+// elements for which it is false, both in their original order. The two
+// results share a single allocation.
+//
+// Time Complexity: O(n * m) (where m = complexity of predicate)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements.
+func SlicePartition[T any](sl []T, pred UnaryPredicate[T]) (matched []T, unmatched []T) {
+	buf := make([]T, len(sl))
+	front, back := 0, len(sl)
+	for i := 0; i < len(sl); i++ {
+		if pred(sl[i]) {
+			buf[front] = sl[i]
+			front++
+		} else {
+			back--
+			buf[back] = sl[i]
+		}
+	}
+	// The unmatched elements were written from the back, so they are in
+	// reverse order.
+	for i, j := back, len(buf)-1; i < j; i, j = i+1, j-1 {
+		buf[i], buf[j] = buf[j], buf[i]
+	}
+	return buf[:front:front], buf[front:]
+}
+
+// Split a slice into consecutive chunks of size elements. The last chunk
+// holds the remaining elements and may be shorter. The chunks share memory
+// with the original slice, but appending to a chunk never overwrites the next
+// one. Panics if size is not positive.
+//
+// Sometimes known by other names: Batch
+//
+// Time Complexity: O(n / size)
+// Space Complexity: O(n / size)
+// Allocations: 1 slice, ceil(n / size) elements.
+func SliceChunk[T any](sl []T, size int) [][]T {
+	if size <= 0 {
+		panic("collections: SliceChunk size must be positive")
+	}
+	chunks := make([][]T, 0, (len(sl)+size-1)/size)
+	for lo := 0; lo < len(sl); lo += size {
+		hi := lo + size
+		if hi > len(sl) {
+			hi = len(sl)
+		}
+		chunks = append(chunks, sl[lo:hi:hi])
+	}
+	return chunks
+}
+
+// Produce every window of size consecutive elements, starting a new window
+// every step elements. Only full windows are produced, so a slice shorter
+// than size produces none. The windows share memory with the original
+// slice. Panics if size or step is not positive.
+//
+// Sometimes known by other names: Sliding
+//
+// Time Complexity: O(n / step)
+// Space Complexity: O(n / step)
+// Allocations: 1 slice, (n - size) / step + 1 elements.
+func SliceWindow[T any](sl []T, size int, step int) [][]T {
+	if size <= 0 || step <= 0 {
+		panic("collections: SliceWindow size and step must be positive")
+	}
+	if len(sl) < size {
+		return [][]T{}
+	}
+	windows := make([][]T, 0, (len(sl)-size)/step+1)
+	for lo := 0; lo+size <= len(sl); lo += step {
+		windows = append(windows, sl[lo:lo+size:lo+size])
+	}
+	return windows
+}
+
+// Combine two slices into a slice of pairs, where the i-th pair holds the
+// i-th element of each slice. The result is as long as the shorter slice.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 1 slice, min(n, m) elements.
+func SliceZip[A any, B any](a []A, b []B) []Pair[A, B] {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	result := make([]Pair[A, B], n)
+	for i := 0; i < n; i++ {
+		result[i] = Pair[A, B]{a[i], b[i]}
+	}
+	return result
+}
+
+// Split a slice of pairs into a slice of the first values and a slice of the
+// second values. This is the reverse of SliceZip.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 2 slices, n elements.
+func SliceUnzip[A any, B any](pairs []Pair[A, B]) ([]A, []B) {
+	a := make([]A, len(pairs))
+	b := make([]B, len(pairs))
+	for i := 0; i < len(pairs); i++ {
+		a[i], b[i] = pairs[i].First, pairs[i].Second
+	}
+	return a, b
+}
+
+// Create a map from a key computed from each element to the element. If two
+// elements have the same key, the later one is kept.
+//
+// Sometimes known by other names: IndexBy, Associate
+//
+// Time Complexity: O(n * m) (where m = complexity of key)
+// Space Complexity: O(n)
+// Allocations: 1 map, n elements.
+func SliceKeyBy[T any, K comparable](sl []T, key Mapper[T, K]) map[K]T {
+	result := make(map[K]T, len(sl))
+	for i := 0; i < len(sl); i++ {
+		result[key(sl[i])] = sl[i]
+	}
+	return result
+}
+
+// Create a map from the key and value pair computed from each element. If
+// two elements produce the same key, the later one is kept.
+//
+// Time Complexity: O(n * m) (where m = complexity of entry)
+// Space Complexity: O(n)
+// Allocations: 1 map, n elements.
+func SliceToMap[T any, K comparable, V any](sl []T, entry func(T) (K, V)) map[K]V {
+	result := make(map[K]V, len(sl))
+	for i := 0; i < len(sl); i++ {
+		k, v := entry(sl[i])
+		result[k] = v
+	}
+	return result
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/RageCage64/collections-go"
+	"github.com/RageCage64/go-assert"
+)
+
+func TestSliceGroupBy(t *testing.T) {
+	groups := collections.SliceGroupBy([]string{"apple", "bean", "avocado", "beet", "corn"}, func(s string) byte {
+		return s[0]
+	})
+	assert.Equal(t, 3, len(groups))
+	assert.SliceEqual(t, []string{"apple", "avocado"}, groups['a'])
+	assert.SliceEqual(t, []string{"bean", "beet"}, groups['b'])
+	assert.SliceEqual(t, []string{"corn"}, groups['c'])
+}
+
+func TestSlicePartition(t *testing.T) {
+	matched, unmatched := collections.SlicePartition(collections.Range(7), isEven)
+	assert.SliceEqual(t, []int{0, 2, 4, 6}, matched)
+	assert.SliceEqual(t, []int{1, 3, 5}, unmatched)
+
+	matched = append(matched, 100)
+	assert.SliceEqual(t, []int{1, 3, 5}, unmatched)
+}
+
+func TestSliceChunk(t *testing.T) {
+	chunks := collections.SliceChunk(collections.Range(7), 3)
+	assert.Equal(t, 3, len(chunks))
+	assert.SliceEqual(t, []int{0, 1, 2}, chunks[0])
+	assert.SliceEqual(t, []int{3, 4, 5}, chunks[1])
+	assert.SliceEqual(t, []int{6}, chunks[2])
+	assert.Equal(t, 0, len(collections.SliceChunk([]int{}, 3)))
+}
+
+func TestSliceWindow(t *testing.T) {
+	windows := collections.SliceWindow(collections.Range(6), 3, 2)
+	assert.Equal(t, 2, len(windows))
+	assert.SliceEqual(t, []int{0, 1, 2}, windows[0])
+	assert.SliceEqual(t, []int{2, 3, 4}, windows[1])
+
+	assert.Equal(t, 4, len(collections.SliceWindow(collections.Range(6), 3, 1)))
+	assert.Equal(t, 0, len(collections.SliceWindow(collections.Range(2), 3, 1)))
+}
+
+func TestSliceZipUnzip(t *testing.T) {
+	pairs := collections.SliceZip([]int{1, 2, 3}, []string{"a", "b"})
+	assert.SliceEqual(
+		t,
+		[]collections.Pair[int, string]{{1, "a"}, {2, "b"}},
+		pairs,
+	)
+
+	nums, strs := collections.SliceUnzip(pairs)
+	assert.SliceEqual(t, []int{1, 2}, nums)
+	assert.SliceEqual(t, []string{"a", "b"}, strs)
+}
+
+func TestSliceKeyByToMap(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	users := []user{{1, "a"}, {2, "b"}}
+
+	byID := collections.SliceKeyBy(users, func(u user) int { return u.id })
+	assert.Equal(t, users[1], byID[2])
+
+	names := collections.SliceToMap(users, func(u user) (int, string) {
+		return u.id, strings.ToUpper(u.name)
+	})
+	assert.Equal(t, "A", names[1])
+	assert.Equal(t, "B", names[2])
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections
+
+/*****************
+The direct API for searching for contiguous subslices.
+*****************/
+
+// Find the index of the first occurrence of sub in the slice, or -1 if it
+// does not occur. An empty sub occurs at index 0.
+//
+// Time Complexity: O(n + m) (where m = length of sub)
+// Space Complexity: O(m)
+// Allocations: 1 int slice, m elements.
+func SliceIndexSubslice[T comparable](sl []T, sub []T) int {
+	if len(sub) == 0 {
+		return 0
+	}
+	result := -1
+	matchSubslice(sl, sub, equal[T], true, func(i int) bool {
+		result = i
+		return false
+	})
+	return result
+}
+
+// Find the index of the last occurrence of sub in the slice, or -1 if it
+// does not occur. An empty sub occurs at index len(sl).
+//
+// Time Complexity: O(n + m) (where m = length of sub)
+// Space Complexity: O(m)
+// Allocations: 1 int slice, m elements.
+func SliceLastIndexSubslice[T comparable](sl []T, sub []T) int {
+	if len(sub) == 0 {
+		return len(sl)
+	}
+	result := -1
+	matchSubslice(sl, sub, equal[T], true, func(i int) bool {
+		result = i
+		return true
+	})
+	return result
+}
+
+// Count the non-overlapping occurrences of sub in the slice. An empty sub
+// occurs before and after every element, so the count is len(sl) + 1.
+//
+// Time Complexity: O(n + m) (where m = length of sub)
+// Space Complexity: O(m)
+// Allocations: 1 int slice, m elements.
+func SliceCountSubslice[T comparable](sl []T, sub []T) int {
+	if len(sub) == 0 {
+		return len(sl) + 1
+	}
+	count := 0
+	matchSubslice(sl, sub, equal[T], false, func(int) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+// Split the slice around each non-overlapping occurrence of sep, returning
+// the parts between them. The parts share memory with the original slice,
+// but their capacity is limited so appending to one will not overwrite the
+// next. If sep does not occur the result holds the whole slice, and if sep
+// is empty the slice is split into single elements.
+//
+// Time Complexity: O(n + m) (where m = length of sep)
+// Space Complexity: O(n + m)
+// Allocations: 1 int slice, m elements. 1 slice, resized as necessary.
+func SliceSplitOn[T comparable](sl []T, sep []T) [][]T {
+	if len(sep) == 0 {
+		parts := make([][]T, len(sl))
+		for i := 0; i < len(sl); i++ {
+			parts[i] = sl[i : i+1 : i+1]
+		}
+		return parts
+	}
+	var parts [][]T
+	start := 0
+	matchSubslice(sl, sep, equal[T], false, func(i int) bool {
+		parts = append(parts, sl[start:i:i])
+		start = i + len(sep)
+		return true
+	})
+	return append(parts, sl[start:len(sl):len(sl)])
+}
+
+// Create a new slice with the first n non-overlapping occurrences of old
+// replaced by replacement. If n < 0, every occurrence is replaced. If old is
+// empty, replacement is inserted at the start of the slice and after each
+// element, up to n times.
+//
+// Time Complexity: O(n + m + r) (where m = length of old, r = length of result)
+// Space Complexity: O(n + m + r)
+// Allocations: 1 int slice, m elements. 1 slice, resized as necessary.
+func SliceReplaceSubslice[T comparable](sl []T, old []T, replacement []T, n int) []T {
+	result := make([]T, 0, len(sl))
+	if len(old) == 0 {
+		for i := 0; i <= len(sl); i++ {
+			if n < 0 || i < n {
+				result = append(result, replacement...)
+			}
+			if i < len(sl) {
+				result = append(result, sl[i])
+			}
+		}
+		return result
+	}
+	start := 0
+	replaced := 0
+	matchSubslice(sl, old, equal[T], false, func(i int) bool {
+		if n >= 0 && replaced == n {
+			return false
+		}
+		result = append(result, sl[start:i]...)
+		result = append(result, replacement...)
+		start = i + len(old)
+		replaced++
+		return true
+	})
+	return append(result, sl[start:]...)
+}
+
+func equal[T comparable](a T, b T) bool {
+	return a == b
+}
+
+// matchSubslice runs the Knuth-Morris-Pratt algorithm to find each
+// occurrence of a non-empty sub in sl, comparing elements with eq. It calls
+// found with the index of each one until it returns false. When overlapping
+// is false, the search resumes after the end of each match rather than
+// inside it.
+func matchSubslice[T any](sl []T, sub []T, eq BinaryPredicate[T], overlapping bool, found func(i int) bool) {
+	if len(sub) > len(sl) {
+		return
+	}
+
+	// prefix[i] is the length of the longest proper prefix of sub[:i+1]
+	// that is also a suffix of it, which is where matching can resume after
+	// a mismatch without re-reading elements of sl.
+	prefix := make([]int, len(sub))
+	for i, k := 1, 0; i < len(sub); i++ {
+		for k > 0 && !eq(sub[i], sub[k]) {
+			k = prefix[k-1]
+		}
+		if eq(sub[i], sub[k]) {
+			k++
+		}
+		prefix[i] = k
+	}
+
+	for i, k := 0, 0; i < len(sl); i++ {
+		for k > 0 && !eq(sl[i], sub[k]) {
+			k = prefix[k-1]
+		}
+		if eq(sl[i], sub[k]) {
+			k++
+		}
+		if k == len(sub) {
+			if !found(i - len(sub) + 1) {
+				return
+			}
+			if overlapping {
+				k = prefix[k-1]
+			} else {
+				k = 0
+			}
+		}
+	}
+}
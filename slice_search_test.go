@@ -0,0 +1,130 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/RageCage64/collections-go"
+	"github.com/RageCage64/go-assert"
+)
+
+// bruteMatches is the oracle for subslice search, checking every position.
+func bruteMatches(sl []byte, sub []byte, overlapping bool) []int {
+	var matches []int
+	for i := 0; i+len(sub) <= len(sl); {
+		if bytes.Equal(sl[i:i+len(sub)], sub) {
+			matches = append(matches, i)
+			if !overlapping && len(sub) > 0 {
+				i += len(sub)
+				continue
+			}
+		}
+		i++
+	}
+	return matches
+}
+
+func TestSliceIndexSubslice(t *testing.T) {
+	sl := []int{1, 1, 2, 1, 1, 2, 3}
+	assert.Equal(t, 1, collections.SliceIndexSubslice(sl, []int{1, 2}))
+	assert.Equal(t, 4, collections.SliceLastIndexSubslice(sl, []int{1, 2}))
+	assert.Equal(t, -1, collections.SliceIndexSubslice(sl, []int{2, 2}))
+	assert.Equal(t, -1, collections.SliceLastIndexSubslice(sl, []int{2, 2}))
+	assert.Equal(t, 0, collections.SliceIndexSubslice(sl, []int{}))
+	assert.Equal(t, 7, collections.SliceLastIndexSubslice(sl, []int{}))
+}
+
+func TestSliceSubsetBacktracks(t *testing.T) {
+	assert.Assert(t, collections.SliceSubset([]int{1, 1, 2}, []int{1, 2}), "expected [1 2] to be a subset of [1 1 2]")
+	assert.Assert(t, collections.SliceSubsetStrict([]int{1, 1, 2}, []int{1, 2}), "expected [1 2] to be a strict subset of [1 1 2]")
+}
+
+func TestSliceSubsetEmpty(t *testing.T) {
+	assert.Assert(t, collections.SliceSubset([]int{1, 2}, []int{}), "expected empty slice to be a subset")
+	assert.Assert(t, collections.SliceSubset([]int{}, []int{}), "expected empty slice to be a subset of itself")
+	assert.Assert(t, !collections.SliceSubsetStrict([]int{}, []int{}), "expected empty slice not to be a strict subset of itself")
+}
+
+func TestSliceCountSubslice(t *testing.T) {
+	assert.Equal(t, 2, collections.SliceCountSubslice([]int{1, 1, 1, 1, 1}, []int{1, 1}))
+	assert.Equal(t, 0, collections.SliceCountSubslice([]int{1, 2}, []int{3}))
+	assert.Equal(t, 3, collections.SliceCountSubslice([]int{1, 2}, []int{}))
+}
+
+func TestSliceSplitOn(t *testing.T) {
+	parts := collections.SliceSplitOn([]int{1, 0, 0, 2, 3, 0, 0, 0, 0}, []int{0, 0})
+	assert.Equal(t, 4, len(parts))
+	assert.SliceEqual(t, []int{1}, parts[0])
+	assert.SliceEqual(t, []int{2, 3}, parts[1])
+	assert.SliceEqual(t, []int{}, parts[2])
+	assert.SliceEqual(t, []int{}, parts[3])
+
+	sl := []int{1, 0, 2}
+	parts = collections.SliceSplitOn(sl, []int{0})
+	_ = append(parts[0], 100)
+	assert.SliceEqual(t, []int{1, 0, 2}, sl)
+}
+
+func TestSliceReplaceSubslice(t *testing.T) {
+	sl := []int{1, 2, 3, 1, 2, 3}
+	assert.SliceEqual(t, []int{9, 3, 9, 3}, collections.SliceReplaceSubslice(sl, []int{1, 2}, []int{9}, -1))
+	assert.SliceEqual(t, []int{9, 3, 1, 2, 3}, collections.SliceReplaceSubslice(sl, []int{1, 2}, []int{9}, 1))
+	assert.SliceEqual(t, []int{0, 1, 0, 2}, collections.SliceReplaceSubslice([]int{1, 2}, []int{}, []int{0}, 2))
+	assert.SliceEqual(t, []int{1, 2, 3, 1, 2, 3}, sl)
+}
+
+func FuzzSliceSubslice(f *testing.F) {
+	f.Add([]byte{1, 1, 2}, []byte{1, 2})
+	f.Add([]byte{1, 2, 1, 2, 1}, []byte{1, 2, 1})
+	f.Add([]byte{}, []byte{})
+	f.Add([]byte{1, 2}, []byte{})
+	f.Add([]byte{0, 0, 0, 0}, []byte{0, 0})
+	f.Fuzz(func(t *testing.T, sl []byte, sub []byte) {
+		all := bruteMatches(sl, sub, true)
+		first, last := -1, -1
+		if len(all) > 0 {
+			first, last = all[0], all[len(all)-1]
+		}
+		assert.Equal(t, first, collections.SliceIndexSubslice(sl, sub))
+		assert.Equal(t, last, collections.SliceLastIndexSubslice(sl, sub))
+		assert.Equal(t, first >= 0, collections.SliceSubset(sl, sub))
+		assert.Equal(t, first >= 0 && len(sub) < len(sl), collections.SliceSubsetStrict(sl, sub))
+
+		if len(sub) == 0 {
+			// bytes splits and replaces around UTF-8 sequences rather than
+			// single bytes for an empty separator, so it is not an oracle
+			// for this case.
+			return
+		}
+		assert.Equal(t, len(bruteMatches(sl, sub, false)), collections.SliceCountSubslice(sl, sub))
+
+		parts := collections.SliceSplitOn(sl, sub)
+		expected := bytes.Split(sl, sub)
+		assert.Equal(t, len(expected), len(parts))
+		for i := range expected {
+			assert.SliceEqual(t, expected[i], parts[i])
+		}
+
+		replaced := collections.SliceReplaceSubslice(sl, sub, []byte{0xff}, -1)
+		assert.SliceEqual(t, bytes.ReplaceAll(sl, sub, []byte{0xff}), replaced)
+	})
+}
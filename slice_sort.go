@@ -0,0 +1,286 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections
+
+import "sort"
+
+// LessFunc is a function that reports whether a should be ordered before b.
+// It must describe a strict weak ordering.
+type LessFunc[T any] func(a T, b T) bool
+
+// CompareFunc is a function that returns a negative number if a should be
+// ordered before b, a positive number if after, and 0 if they are equivalent.
+// It must describe a strict weak ordering.
+type CompareFunc[T any] func(a T, b T) int
+
+/*****************
+The alias API for ordered slices.
+*****************/
+
+// OrderedSlice is an alias over a Go slice whose elements are Ordered,
+// which enables ordering methods such as Sort and BinarySearch on top of the
+// methods available on Slice.
+type OrderedSlice[T Ordered] []T
+
+// Check if a slice contains an element. Calls SliceContains.
+func (sl OrderedSlice[T]) Contains(needle T) bool {
+	return SliceContains(sl, needle)
+}
+
+// Check if a slice contains each individual element from another slice.
+// Calls SliceContainsEach.
+func (sl OrderedSlice[T]) ContainsEach(needles []T) bool {
+	return SliceContainsEach(sl, needles)
+}
+
+// Check if another slice is a (non-strict) subset of the slice. Calls SliceSubset.
+func (sl OrderedSlice[T]) Subset(sub []T) bool {
+	return SliceSubset(sl, sub)
+}
+
+// Run an operator on every element in the slice, and return a slice that
+// contains the result of every operation. Calls SliceMap.
+func (sl OrderedSlice[T]) Map(op UnaryOperator[T]) OrderedSlice[T] {
+	return SliceMap(sl, op)
+}
+
+// Run a predicate on every element in the slice, and return a slice
+// that contains every element for which the predicate was true. Calls
+// SliceFilter.
+func (sl OrderedSlice[T]) Filter(pred UnaryPredicate[T]) OrderedSlice[T] {
+	return SliceFilter(sl, pred)
+}
+
+// Run an operation using every element of the slice one at a time.
+func (sl OrderedSlice[T]) ForEach(do UnaryReceiver[T]) {
+	SliceForEach(sl, do)
+}
+
+// Sort the slice in place in ascending order. Calls SliceSort.
+func (sl OrderedSlice[T]) Sort() {
+	SliceSort(sl)
+}
+
+// Check if the slice is sorted in ascending order. Calls SliceIsSorted.
+func (sl OrderedSlice[T]) IsSorted() bool {
+	return SliceIsSorted(sl)
+}
+
+// Search the sorted slice for a value. Calls SliceBinarySearch.
+func (sl OrderedSlice[T]) BinarySearch(target T) (int, bool) {
+	return SliceBinarySearch(sl, target)
+}
+
+// Insert a value into the sorted slice, keeping it sorted. Calls
+// SliceInsertSorted.
+func (sl OrderedSlice[T]) InsertSorted(v T) OrderedSlice[T] {
+	return SliceInsertSorted(sl, v)
+}
+
+// Merge another sorted slice with the sorted slice. Calls SliceMergeSorted.
+func (sl OrderedSlice[T]) MergeSorted(other []T) OrderedSlice[T] {
+	return SliceMergeSorted(sl, other)
+}
+
+/*****************
+The direct API for sorting and searching.
+*****************/
+
+type lessSorter[T any] struct {
+	sl   []T
+	less LessFunc[T]
+}
+
+func (s lessSorter[T]) Len() int           { return len(s.sl) }
+func (s lessSorter[T]) Less(i, j int) bool { return s.less(s.sl[i], s.sl[j]) }
+func (s lessSorter[T]) Swap(i, j int)      { s.sl[i], s.sl[j] = s.sl[j], s.sl[i] }
+
+func orderedLess[T Ordered](a T, b T) bool {
+	return a < b
+}
+
+// Sort a slice in place in ascending order. The sort is not stable.
+//
+// Time Complexity: O(n log n)
+// Space Complexity: O(log n)
+// Allocations: 1 (sort.Interface value)
+func SliceSort[T Ordered](sl []T) {
+	sort.Sort(lessSorter[T]{sl, orderedLess[T]})
+}
+
+// Sort a slice in place using a less function. The sort is not stable.
+//
+// Time Complexity: O(n log n * m) (where m = complexity of less)
+// Space Complexity: O(log n)
+// Allocations: 1 (sort.Interface value)
+func SliceSortFunc[T any](sl []T, less LessFunc[T]) {
+	sort.Sort(lessSorter[T]{sl, less})
+}
+
+// Sort a slice in place using a less function, keeping equal elements in
+// their original order.
+//
+// Time Complexity: O(n log^2 n * m) (where m = complexity of less)
+// Space Complexity: O(1)
+// Allocations: 1 (sort.Interface value)
+func SliceSortStableFunc[T any](sl []T, less LessFunc[T]) {
+	sort.Stable(lessSorter[T]{sl, less})
+}
+
+type keySorter[T any, K Ordered] struct {
+	sl   []T
+	keys []K
+}
+
+func (s keySorter[T, K]) Len() int           { return len(s.sl) }
+func (s keySorter[T, K]) Less(i, j int) bool { return s.keys[i] < s.keys[j] }
+func (s keySorter[T, K]) Swap(i, j int) {
+	s.sl[i], s.sl[j] = s.sl[j], s.sl[i]
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
+
+// Sort a slice in place in ascending order of a key computed from each
+// element. The key function is called exactly once per element, which makes
+// this a better choice than SliceSortFunc when computing the key is
+// expensive. The sort is stable.
+//
+// Sometimes known by other names: Schwartzian transform, decorate-sort-undecorate
+//
+// Time Complexity: O(n * m + n log^2 n) (where m = complexity of key)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n keys. 1 (sort.Interface value)
+func SliceSortBy[T any, K Ordered](sl []T, key Mapper[T, K]) {
+	keys := SliceMapTo(sl, key)
+	sort.Stable(keySorter[T, K]{sl, keys})
+}
+
+// Check if a slice is sorted in ascending order.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+// Allocations: None
+func SliceIsSorted[T Ordered](sl []T) bool {
+	return SliceIsSortedFunc(sl, orderedLess[T])
+}
+
+// Check if a slice is sorted according to a less function.
+//
+// Time Complexity: O(n * m) (where m = complexity of less)
+// Space Complexity: O(1)
+// Allocations: None
+func SliceIsSortedFunc[T any](sl []T, less LessFunc[T]) bool {
+	for i := 1; i < len(sl); i++ {
+		if less(sl[i], sl[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Search a slice sorted in ascending order for a value. Returns the index of
+// the value and true if it is found. Otherwise returns the index where the
+// value would be inserted to keep the slice sorted, and false. If the value
+// appears more than once, the index of the first one is returned.
+//
+// Time Complexity: O(log n)
+// Space Complexity: O(1)
+// Allocations: None
+func SliceBinarySearch[T Ordered](sl []T, target T) (int, bool) {
+	lo, hi := 0, len(sl)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if sl[mid] < target {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, lo < len(sl) && sl[lo] == target
+}
+
+// Search a sorted slice using a comparison function, which returns a
+// negative number if the element is ordered before the target, zero if it
+// matches, and a positive number if it is ordered after. The target may be a
+// different type to the elements, such as a key field of a struct. Returns
+// the same results as SliceBinarySearch.
+//
+// Time Complexity: O(log n * m) (where m = complexity of cmp)
+// Space Complexity: O(1)
+// Allocations: None
+func SliceBinarySearchFunc[T any, K any](sl []T, target K, cmp func(el T, target K) int) (int, bool) {
+	lo, hi := 0, len(sl)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if cmp(sl[mid], target) < 0 {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, lo < len(sl) && cmp(sl[lo], target) == 0
+}
+
+// Insert a value into a slice sorted in ascending order, keeping it sorted.
+// Like append, the result must be used in place of the original slice.
+// Equal values are inserted after any existing ones.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+// Allocations: Slice resize
+func SliceInsertSorted[T Ordered](sl []T, v T) []T {
+	lo, hi := 0, len(sl)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if v < sl[mid] {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	var zero T
+	sl = append(sl, zero)
+	copy(sl[lo+1:], sl[lo:])
+	sl[lo] = v
+	return sl
+}
+
+// Merge two slices sorted in ascending order into a new sorted slice. When
+// the slices contain equal values, the values from a come first.
+//
+// Time Complexity: O(n + m)
+// Space Complexity: O(n + m)
+// Allocations: 1 slice, n + m elements
+func SliceMergeSorted[T Ordered](a []T, b []T) []T {
+	result := make([]T, len(a)+len(b))
+	i, j, k := 0, 0, 0
+	for i < len(a) && j < len(b) {
+		if b[j] < a[i] {
+			result[k] = b[j]
+			j++
+		} else {
+			result[k] = a[i]
+			i++
+		}
+		k++
+	}
+	k += copy(result[k:], a[i:])
+	copy(result[k:], b[j:])
+	return result
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/RageCage64/collections-go"
+	"github.com/RageCage64/go-assert"
+)
+
+func TestOrderedSliceSort(t *testing.T) {
+	sl := collections.OrderedSlice[int]{5, 2, 4, 1, 3}
+	assert.Assert(t, !sl.IsSorted(), "expected %v not to be sorted", sl)
+	sl.Sort()
+	assert.SliceEqual(t, []int{1, 2, 3, 4, 5}, sl)
+	assert.Assert(t, sl.IsSorted(), "expected %v to be sorted", sl)
+}
+
+func TestSliceSortFunc(t *testing.T) {
+	sl := []string{"bb", "a", "ccc"}
+	collections.SliceSortFunc(sl, func(a, b string) bool { return len(a) > len(b) })
+	assert.SliceEqual(t, []string{"ccc", "bb", "a"}, sl)
+}
+
+func TestSliceSortStableFunc(t *testing.T) {
+	sl := []string{"b1", "a1", "b2", "a2", "b3"}
+	collections.SliceSortStableFunc(sl, func(a, b string) bool { return a[0] < b[0] })
+	assert.SliceEqual(t, []string{"a1", "a2", "b1", "b2", "b3"}, sl)
+}
+
+func TestSliceSortBy(t *testing.T) {
+	calls := 0
+	sl := []string{"Banana", "apple", "Cherry", "apricot"}
+	collections.SliceSortBy(sl, func(s string) string {
+		calls++
+		return strings.ToLower(s)
+	})
+	assert.SliceEqual(t, []string{"apple", "apricot", "Banana", "Cherry"}, sl)
+	assert.Equal(t, len(sl), calls)
+}
+
+func TestSliceBinarySearch(t *testing.T) {
+	sl := collections.OrderedSlice[int]{1, 3, 3, 5}
+	testCases := []struct {
+		target int
+		index  int
+		found  bool
+	}{
+		{0, 0, false},
+		{1, 0, true},
+		{3, 1, true},
+		{4, 3, false},
+		{6, 4, false},
+	}
+	for _, tc := range testCases {
+		index, found := sl.BinarySearch(tc.target)
+		assert.Equal(t, tc.index, index)
+		assert.Equal(t, tc.found, found)
+	}
+}
+
+func TestSliceBinarySearchFunc(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	users := []user{{1, "a"}, {4, "b"}, {9, "c"}}
+	byID := func(u user, id int) int { return u.id - id }
+
+	index, found := collections.SliceBinarySearchFunc(users, 4, byID)
+	assert.Assert(t, found, "expected to find id 4")
+	assert.Equal(t, 1, index)
+	index, found = collections.SliceBinarySearchFunc(users, 5, byID)
+	assert.Assert(t, !found, "expected not to find id 5")
+	assert.Equal(t, 2, index)
+}
+
+func TestSliceInsertSorted(t *testing.T) {
+	var sl collections.OrderedSlice[int]
+	for _, v := range []int{3, 1, 2, 5, 4, 1} {
+		sl = sl.InsertSorted(v)
+	}
+	assert.SliceEqual(t, []int{1, 1, 2, 3, 4, 5}, sl)
+}
+
+func TestSliceMergeSorted(t *testing.T) {
+	result := collections.SliceMergeSorted([]int{1, 4, 6}, []int{2, 3, 7, 8})
+	assert.SliceEqual(t, []int{1, 2, 3, 4, 6, 7, 8}, result)
+	assert.SliceEqual(t, []int{1}, collections.OrderedSlice[int]{}.MergeSorted([]int{1}))
+}
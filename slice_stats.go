@@ -0,0 +1,250 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections
+
+import "math"
+
+/*****************
+The direct API for numeric aggregates over slices.
+
+For float types, NaN is handled the same way by every function here: a NaN
+element makes the result NaN, the same as it would in a sum. The exceptions
+are SliceMinBy and SliceMaxBy, which skip over NaN keys, since they return
+an element rather than a number.
+*****************/
+
+// Add up every element in the slice. Returns 0 for an empty slice. Integer
+// sums wrap around on overflow, the same as the + operator.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+// Allocations: None
+func SliceSum[T Number](sl []T) T {
+	var sum T
+	for i := 0; i < len(sl); i++ {
+		sum += sl[i]
+	}
+	return sum
+}
+
+// Find the smallest element in the slice. Returns false if the slice is
+// empty, and NaN if the slice contains NaN.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+// Allocations: None
+func SliceMin[T Ordered](sl []T) (T, bool) {
+	return sliceExtreme(sl, func(x T, y T) bool { return x < y })
+}
+
+// Find the largest element in the slice. Returns false if the slice is
+// empty, and NaN if the slice contains NaN.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+// Allocations: None
+func SliceMax[T Ordered](sl []T) (T, bool) {
+	return sliceExtreme(sl, func(x T, y T) bool { return x > y })
+}
+
+func sliceExtreme[T Ordered](sl []T, better func(x T, y T) bool) (T, bool) {
+	if len(sl) == 0 {
+		return *new(T), false
+	}
+	result := sl[0]
+	for i := 0; i < len(sl); i++ {
+		// Only NaN is not equal to itself.
+		if sl[i] != sl[i] {
+			return sl[i], true
+		}
+		if better(sl[i], result) {
+			result = sl[i]
+		}
+	}
+	return result, true
+}
+
+// Find the element with the smallest key. Ties go to the earliest element.
+// Elements with a NaN key are only chosen if every key is NaN. Returns false
+// if the slice is empty.
+//
+// Time Complexity: O(n * m) (where m = complexity of key)
+// Space Complexity: O(1)
+// Allocations: None
+func SliceMinBy[T any, K Ordered](sl []T, key Mapper[T, K]) (T, bool) {
+	return sliceExtremeBy(sl, key, func(x K, y K) bool { return x < y })
+}
+
+// Find the element with the largest key. Ties go to the earliest element.
+// Elements with a NaN key are only chosen if every key is NaN. Returns false
+// if the slice is empty.
+//
+// Time Complexity: O(n * m) (where m = complexity of key)
+// Space Complexity: O(1)
+// Allocations: None
+func SliceMaxBy[T any, K Ordered](sl []T, key Mapper[T, K]) (T, bool) {
+	return sliceExtremeBy(sl, key, func(x K, y K) bool { return x > y })
+}
+
+func sliceExtremeBy[T any, K Ordered](sl []T, key Mapper[T, K], better func(x K, y K) bool) (T, bool) {
+	if len(sl) == 0 {
+		return *new(T), false
+	}
+	result, best := sl[0], key(sl[0])
+	for i := 1; i < len(sl); i++ {
+		k := key(sl[i])
+		// Replace a NaN best with anything, but never replace with NaN.
+		if (best != best && k == k) || better(k, best) {
+			result, best = sl[i], k
+		}
+	}
+	return result, true
+}
+
+// Calculate the arithmetic mean of the slice. Returns false if the slice is
+// empty.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+// Allocations: None
+func SliceMean[T Number](sl []T) (float64, bool) {
+	if len(sl) == 0 {
+		return 0, false
+	}
+	sum := 0.0
+	for i := 0; i < len(sl); i++ {
+		sum += float64(sl[i])
+	}
+	return sum / float64(len(sl)), true
+}
+
+// Calculate the population variance of the slice, using Welford's method so
+// the result stays accurate when the values are large compared to their
+// spread. For the sample variance, multiply by n / (n - 1). Returns false if
+// the slice is empty.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+// Allocations: None
+func SliceVariance[T Number](sl []T) (float64, bool) {
+	if len(sl) == 0 {
+		return 0, false
+	}
+	mean, m2 := 0.0, 0.0
+	for i := 0; i < len(sl); i++ {
+		x := float64(sl[i])
+		delta := x - mean
+		mean += delta / float64(i+1)
+		m2 += delta * (x - mean)
+	}
+	return m2 / float64(len(sl)), true
+}
+
+// Calculate the median of the slice. For an even number of elements this is
+// the mean of the middle two. The original slice is not modified. Returns
+// false if the slice is empty.
+//
+// Time Complexity: O(n) on average
+// Space Complexity: O(n)
+// Allocations: 1 float64 slice, n elements.
+func SliceMedian[T Number](sl []T) (float64, bool) {
+	return SlicePercentile(sl, 50)
+}
+
+// Calculate the p-th percentile of the slice, for p between 0 and 100. When
+// the percentile falls between two elements, the result is linearly
+// interpolated between them, so the 50th percentile is the median. The
+// original slice is not modified. Returns false if the slice is empty or p is
+// outside [0, 100].
+//
+// Time Complexity: O(n) on average
+// Space Complexity: O(n)
+// Allocations: 1 float64 slice, n elements.
+func SlicePercentile[T Number](sl []T, p float64) (float64, bool) {
+	if len(sl) == 0 || !(p >= 0 && p <= 100) {
+		return 0, false
+	}
+	values := make([]float64, len(sl))
+	for i := 0; i < len(sl); i++ {
+		values[i] = float64(sl[i])
+		if math.IsNaN(values[i]) {
+			return values[i], true
+		}
+	}
+
+	rank := p / 100 * float64(len(values)-1)
+	k := int(rank)
+	lower := quickselect(values, k)
+	frac := rank - float64(k)
+	if frac == 0 {
+		return lower, true
+	}
+	// quickselect leaves every element after k no smaller than it, so the
+	// next ranked element is the smallest of those.
+	upper, _ := SliceMin(values[k+1:])
+	return lower + frac*(upper-lower), true
+}
+
+// quickselect rearranges values so that values[k] is the element that would
+// be there if values were sorted, with nothing smaller after it, and returns
+// it.
+func quickselect(values []float64, k int) float64 {
+	lo, hi := 0, len(values)-1
+	for lo < hi {
+		pivot := medianOfThree(values[lo], values[lo+(hi-lo)/2], values[hi])
+		i, j := lo, hi
+		for i <= j {
+			for values[i] < pivot {
+				i++
+			}
+			for values[j] > pivot {
+				j--
+			}
+			if i <= j {
+				values[i], values[j] = values[j], values[i]
+				i++
+				j--
+			}
+		}
+		switch {
+		case k <= j:
+			hi = j
+		case k >= i:
+			lo = i
+		default:
+			// Everything between j and i is equal to the pivot.
+			return values[k]
+		}
+	}
+	return values[k]
+}
+
+func medianOfThree(a float64, b float64, c float64) float64 {
+	if a > b {
+		a, b = b, a
+	}
+	if b > c {
+		b = c
+	}
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections_test
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/RageCage64/collections-go"
+	"github.com/RageCage64/go-assert"
+)
+
+func assertClose(t *testing.T, expected float64, actual float64) {
+	t.Helper()
+	assert.Assert(t, math.Abs(expected-actual) < 1e-9, "expected %v, got %v", expected, actual)
+}
+
+func TestSliceSum(t *testing.T) {
+	assert.Equal(t, 15, collections.SliceSum([]int{1, 2, 3, 4, 5}))
+	assert.Equal(t, 0, collections.SliceSum([]int{}))
+	assert.Equal(t, 3.5, collections.SliceSum([]float64{1.5, 2}))
+}
+
+func TestSliceMinMax(t *testing.T) {
+	min, ok := collections.SliceMin([]int{3, 1, 2})
+	assert.Assert(t, ok, "expected a minimum")
+	assert.Equal(t, 1, min)
+	max, ok := collections.SliceMax([]string{"b", "c", "a"})
+	assert.Assert(t, ok, "expected a maximum")
+	assert.Equal(t, "c", max)
+
+	_, ok = collections.SliceMin([]int{})
+	assert.Assert(t, !ok, "expected no minimum of an empty slice")
+	_, ok = collections.SliceMax([]int{})
+	assert.Assert(t, !ok, "expected no maximum of an empty slice")
+
+	nan, _ := collections.SliceMin([]float64{1, math.NaN(), 0})
+	assert.Assert(t, math.IsNaN(nan), "expected NaN, got %v", nan)
+}
+
+func TestSliceMinByMaxBy(t *testing.T) {
+	words := []string{"ccc", "a", "bb", "d"}
+	shortest, ok := collections.SliceMinBy(words, func(s string) int { return len(s) })
+	assert.Assert(t, ok, "expected a minimum")
+	assert.Equal(t, "a", shortest)
+	longest, _ := collections.SliceMaxBy(words, func(s string) int { return len(s) })
+	assert.Equal(t, "ccc", longest)
+
+	floats := []float64{math.NaN(), 2, 1}
+	identity := func(f float64) float64 { return f }
+	min, _ := collections.SliceMinBy(floats, identity)
+	assert.Equal(t, 1.0, min)
+	max, _ := collections.SliceMaxBy(floats, identity)
+	assert.Equal(t, 2.0, max)
+
+	_, ok = collections.SliceMinBy([]string{}, func(s string) int { return len(s) })
+	assert.Assert(t, !ok, "expected no minimum of an empty slice")
+}
+
+func TestSliceMeanVariance(t *testing.T) {
+	mean, ok := collections.SliceMean([]int{2, 4, 4, 4, 5, 5, 7, 9})
+	assert.Assert(t, ok, "expected a mean")
+	assertClose(t, 5, mean)
+
+	variance, ok := collections.SliceVariance([]int{2, 4, 4, 4, 5, 5, 7, 9})
+	assert.Assert(t, ok, "expected a variance")
+	assertClose(t, 4, variance)
+
+	// Large values with a small spread lose all precision with the naive
+	// sum of squares formula.
+	variance, _ = collections.SliceVariance([]float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16})
+	assertClose(t, 22.5, variance)
+
+	_, ok = collections.SliceMean([]int{})
+	assert.Assert(t, !ok, "expected no mean of an empty slice")
+	_, ok = collections.SliceVariance([]int{})
+	assert.Assert(t, !ok, "expected no variance of an empty slice")
+}
+
+func TestSliceMedian(t *testing.T) {
+	sl := []int{5, 1, 4, 2, 3}
+	median, ok := collections.SliceMedian(sl)
+	assert.Assert(t, ok, "expected a median")
+	assertClose(t, 3, median)
+	assert.SliceEqual(t, []int{5, 1, 4, 2, 3}, sl)
+
+	median, _ = collections.SliceMedian([]int{4, 1, 3, 2})
+	assertClose(t, 2.5, median)
+
+	_, ok = collections.SliceMedian([]int{})
+	assert.Assert(t, !ok, "expected no median of an empty slice")
+
+	median, _ = collections.SliceMedian([]float64{1, math.NaN(), 3})
+	assert.Assert(t, math.IsNaN(median), "expected NaN, got %v", median)
+}
+
+func TestSlicePercentile(t *testing.T) {
+	sl := []int{15, 20, 35, 40, 50}
+	testCases := map[float64]float64{0: 15, 25: 20, 40: 29, 50: 35, 100: 50}
+	for p, expected := range testCases {
+		actual, ok := collections.SlicePercentile(sl, p)
+		assert.Assert(t, ok, "expected percentile %v", p)
+		assertClose(t, expected, actual)
+	}
+
+	_, ok := collections.SlicePercentile(sl, 101)
+	assert.Assert(t, !ok, "expected no 101st percentile")
+	_, ok = collections.SlicePercentile(sl, math.NaN())
+	assert.Assert(t, !ok, "expected no NaN percentile")
+}
+
+func TestSlicePercentileRandomized(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		sl := make([]int, 1+rng.Intn(30))
+		for j := range sl {
+			sl[j] = rng.Intn(10)
+		}
+		p := rng.Float64() * 100
+
+		sorted := append([]int(nil), sl...)
+		sort.Ints(sorted)
+		rank := p / 100 * float64(len(sorted)-1)
+		lower := float64(sorted[int(rank)])
+		expected := lower
+		if int(rank)+1 < len(sorted) {
+			expected += (rank - float64(int(rank))) * (float64(sorted[int(rank)+1]) - lower)
+		}
+
+		actual, _ := collections.SlicePercentile(sl, p)
+		assertClose(t, expected, actual)
+	}
+}
@@ -20,6 +20,7 @@
 package collections_test
 
 import (
+	"strconv"
 	"testing"
 
 	"github.com/RageCage64/collections-go"
@@ -106,6 +107,47 @@ func TestMap(t *testing.T) {
 	assert.SliceEqual(t, []int{2, 3, 4}, result)
 }
 
+func TestSliceMapTo(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	users := []user{{1, "a"}, {2, "b"}}
+	ids := collections.SliceMapTo(users, func(u user) int {
+		return u.id
+	})
+	assert.SliceEqual(t, []int{1, 2}, ids)
+}
+
+func TestSliceFlatMap(t *testing.T) {
+	result := collections.SliceFlatMap([]int{1, 2, 3}, func(x int) []string {
+		return collections.SliceMapTo(collections.Range(x), func(int) string {
+			return "x"
+		})
+	})
+	assert.SliceEqual(t, []string{"x", "x", "x", "x", "x", "x"}, result)
+}
+
+func TestSliceFlatMapAllocations(t *testing.T) {
+	parts := [][]int{{1, 2}, {}, {3}, {4, 5, 6}}
+	sl := collections.Range(len(parts))
+	var result []int
+	allocs := testing.AllocsPerRun(10, func() {
+		result = collections.SliceFlatMap(sl, func(i int) []int { return parts[i] })
+	})
+	assert.Equal(t, 2.0, allocs)
+	assert.SliceEqual(t, []int{1, 2, 3, 4, 5, 6}, result)
+	assert.Equal(t, 6, cap(result))
+}
+
+func TestSliceFilterMap(t *testing.T) {
+	result := collections.SliceFilterMap([]string{"1", "a", "3"}, func(s string) (int, bool) {
+		n, err := strconv.Atoi(s)
+		return n, err == nil
+	})
+	assert.SliceEqual(t, []int{1, 3}, result)
+}
+
 func TestFilter(t *testing.T) {
 	sl := collections.Slice[int]{1, 2, 3, 4}
 	result := sl.Filter(
@@ -133,3 +175,74 @@ func TestForEach(t *testing.T) {
 	})
 	assert.Equal(t, 5, result)
 }
+
+type tagged struct {
+	name string
+	tags []string
+}
+
+func taggedEqual(a tagged, b tagged) bool {
+	return a.name == b.name && collections.SliceEqualFunc(a.tags, b.tags, func(x string, y string) bool {
+		return x == y
+	})
+}
+
+func TestAnySliceFind(t *testing.T) {
+	sl := collections.AnySlice[tagged]{
+		{"a", []string{"x"}},
+		{"b", []string{"y", "z"}},
+		{"c", []string{"y"}},
+	}
+	hasY := func(el tagged) bool { return collections.SliceContains(el.tags, "y") }
+
+	assert.Assert(t, sl.ContainsFunc(hasY), "expected an element tagged y")
+	assert.Equal(t, 1, sl.IndexFunc(hasY))
+	assert.Equal(t, 2, sl.LastIndexFunc(hasY))
+
+	first, ok := sl.Find(hasY)
+	assert.Assert(t, ok, "expected to find an element tagged y")
+	assert.Equal(t, "b", first.name)
+	last, ok := sl.FindLast(hasY)
+	assert.Assert(t, ok, "expected to find an element tagged y")
+	assert.Equal(t, "c", last.name)
+
+	hasW := func(el tagged) bool { return collections.SliceContains(el.tags, "w") }
+	assert.Assert(t, !sl.ContainsFunc(hasW), "expected no element tagged w")
+	assert.Equal(t, -1, sl.IndexFunc(hasW))
+	assert.Equal(t, -1, sl.LastIndexFunc(hasW))
+	_, ok = sl.Find(hasW)
+	assert.Assert(t, !ok, "expected not to find an element tagged w")
+	_, ok = sl.FindLast(hasW)
+	assert.Assert(t, !ok, "expected not to find an element tagged w")
+}
+
+func TestAnySliceEqualFunc(t *testing.T) {
+	sl := collections.AnySlice[tagged]{{"a", []string{"x"}}, {"b", nil}}
+	assert.Assert(
+		t,
+		sl.EqualFunc([]tagged{{"a", []string{"x"}}, {"b", []string{}}}, taggedEqual),
+		"expected slices to be equal",
+	)
+	assert.Assert(t, !sl.EqualFunc([]tagged{{"a", []string{"x"}}}, taggedEqual), "expected different lengths not to be equal")
+	assert.Assert(t, !sl.EqualFunc([]tagged{{"a", nil}, {"b", nil}}, taggedEqual), "expected different elements not to be equal")
+}
+
+func TestAnySliceContainsEachFunc(t *testing.T) {
+	sl := collections.AnySlice[tagged]{{"a", nil}, {"b", nil}, {"c", nil}}
+	assert.Assert(t, sl.ContainsEachFunc([]tagged{{"c", nil}, {"a", nil}}, taggedEqual), "expected to contain each of c, a")
+	assert.Assert(t, !sl.ContainsEachFunc([]tagged{{"c", nil}, {"d", nil}}, taggedEqual), "expected not to contain d")
+}
+
+func TestAnySliceSubsetFunc(t *testing.T) {
+	sl := collections.AnySlice[tagged]{{"a", nil}, {"a", nil}, {"b", nil}}
+	assert.Assert(t, sl.SubsetFunc([]tagged{{"a", nil}, {"b", nil}}, taggedEqual), "expected [a b] to be a subset")
+	assert.Assert(t, sl.SubsetFunc([]tagged{}, taggedEqual), "expected empty slice to be a subset")
+	assert.Assert(t, !sl.SubsetFunc([]tagged{{"b", nil}, {"a", nil}}, taggedEqual), "expected [b a] not to be a subset")
+}
+
+func TestSliceFilterDropsOne(t *testing.T) {
+	result := collections.SliceFilter([]int{1, 2, 3, 4}, func(x int) bool {
+		return x != 3
+	})
+	assert.SliceEqual(t, []int{1, 2, 4}, result)
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections
+
+/*****************
+The direct API for finding and removing duplicates.
+*****************/
+
+// Create a new slice with the first occurrence of each element, keeping the
+// original order. Unlike NewSetFromSlice, the order of the elements is
+// preserved.
+//
+// Sometimes known by other names: Distinct, Uniq
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 1 set, n elements. 1 slice, n elements.
+func SliceUnique[T comparable](sl []T) []T {
+	return SliceUniqueBy(sl, func(el T) T { return el })
+}
+
+// Create a new slice with the first element for each distinct key computed
+// from the elements, keeping the original order.
+//
+// Time Complexity: O(n * m) (where m = complexity of key)
+// Space Complexity: O(n)
+// Allocations: 1 set, n elements. 1 slice, n elements.
+func SliceUniqueBy[T any, K comparable](sl []T, key Mapper[T, K]) []T {
+	seen := make(Set[K], len(sl))
+	result := make([]T, 0, len(sl))
+	for i := 0; i < len(sl); i++ {
+		k := key(sl[i])
+		if seen.Contains(k) {
+			continue
+		}
+		seen.Add(k)
+		result = append(result, sl[i])
+	}
+	return result
+}
+
+// Replace each run of consecutive equal elements with a single copy, in
+// place. Returns the shortened slice, which shares memory with the original.
+// Elements beyond the new length are left as they were.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(1)
+// Allocations: None
+func SliceCompact[T comparable](sl []T) []T {
+	if len(sl) < 2 {
+		return sl
+	}
+	k := 1
+	for i := 1; i < len(sl); i++ {
+		if sl[i] != sl[k-1] {
+			sl[k] = sl[i]
+			k++
+		}
+	}
+	return sl[:k]
+}
+
+// Count how many times each element appears in the slice.
+//
+// Sometimes known by other names: Histogram, CountBy
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 1 map, up to n elements.
+func SliceFrequencies[T comparable](sl []T) map[T]int {
+	freqs := map[T]int{}
+	for i := 0; i < len(sl); i++ {
+		freqs[sl[i]]++
+	}
+	return freqs
+}
+
+// Find every element that appears more than once, mapped to every index it
+// appears at in ascending order, including the first. Elements that appear
+// only once are not included.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 2 maps, up to n elements. 1 slice per repeated element.
+func SliceDuplicateIndices[T comparable](sl []T) map[T][]int {
+	first := make(map[T]int, len(sl))
+	dupes := map[T][]int{}
+	for i := 0; i < len(sl); i++ {
+		firstIdx, seen := first[sl[i]]
+		if !seen {
+			first[sl[i]] = i
+			continue
+		}
+		if _, ok := dupes[sl[i]]; !ok {
+			dupes[sl[i]] = []int{firstIdx}
+		}
+		dupes[sl[i]] = append(dupes[sl[i]], i)
+	}
+	return dupes
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/RageCage64/collections-go"
+	"github.com/RageCage64/go-assert"
+)
+
+func TestSliceUnique(t *testing.T) {
+	result := collections.SliceUnique([]int{3, 1, 3, 2, 1})
+	assert.SliceEqual(t, []int{3, 1, 2}, result)
+}
+
+func TestSliceUniqueBy(t *testing.T) {
+	result := collections.SliceUniqueBy([]string{"a", "B", "A", "b", "c"}, strings.ToLower)
+	assert.SliceEqual(t, []string{"a", "B", "c"}, result)
+}
+
+func TestSliceCompact(t *testing.T) {
+	sl := []int{1, 1, 2, 2, 2, 1, 3, 3}
+	result := collections.SliceCompact(sl)
+	assert.SliceEqual(t, []int{1, 2, 1, 3}, result)
+	assert.SliceEqual(t, []int{1, 2, 1, 3}, sl[:4])
+	assert.SliceEqual(t, []int{}, collections.SliceCompact([]int{}))
+}
+
+func TestSliceFrequencies(t *testing.T) {
+	freqs := collections.SliceFrequencies([]string{"a", "b", "a", "c", "a"})
+	assert.Equal(t, 3, len(freqs))
+	assert.Equal(t, 3, freqs["a"])
+	assert.Equal(t, 1, freqs["b"])
+	assert.Equal(t, 1, freqs["c"])
+}
+
+func TestSliceDuplicateIndices(t *testing.T) {
+	dupes := collections.SliceDuplicateIndices([]string{"a", "b", "a", "c", "b", "a"})
+	assert.Equal(t, 2, len(dupes))
+	assert.SliceEqual(t, []int{0, 2, 5}, dupes["a"])
+	assert.SliceEqual(t, []int{1, 4}, dupes["b"])
+}
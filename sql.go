@@ -0,0 +1,384 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SQLElement is the set of element types that SQLSet and SQLSlice know how
+// to read from and write to a database column.
+type SQLElement interface {
+	string | int64
+}
+
+// SQLArrayFormat selects how SQLSet and SQLSlice are represented in the
+// database column.
+type SQLArrayFormat int
+
+const (
+	// SQLArrayPostgres uses the PostgreSQL array literal format, such as
+	// {a,"b c",NULL}. This suits text[] and bigint[] columns.
+	SQLArrayPostgres SQLArrayFormat = iota
+	// SQLArrayJSON uses a JSON array, such as ["a","b c"]. This suits json,
+	// jsonb and plain text columns.
+	SQLArrayJSON
+)
+
+var (
+	ErrInvalidSQLArray = errors.New("collections: invalid SQL array literal")
+	ErrSQLNullElement  = errors.New("collections: SQL array contains a NULL element")
+)
+
+// SQLSet wraps a Set so it can be used directly as a query argument and
+// as a Scan destination with database/sql. A SQL NULL is read as a nil Set,
+// and a nil Set is written as SQL NULL. NULL elements inside the array can't
+// be represented in a Set, so scanning them returns ErrSQLNullElement.
+// Elements are written in sorted order so the stored value is stable.
+type SQLSet[T SQLElement] struct {
+	Set    Set[T]
+	Format SQLArrayFormat
+}
+
+// Scan implements sql.Scanner.
+func (s *SQLSet[T]) Scan(src any) error {
+	raw, isNull, err := sqlSource(src, "SQLSet")
+	if err != nil {
+		return err
+	}
+	if isNull {
+		s.Set = nil
+		return nil
+	}
+	if s.Format == SQLArrayJSON {
+		sl, err := parseJSONArray[T](raw)
+		if err != nil {
+			return err
+		}
+		if sl == nil {
+			s.Set = nil
+		} else {
+			s.Set = NewSetFromSlice(sl)
+		}
+		return nil
+	}
+	set := Set[T]{}
+	err = parsePostgresArray(string(raw), func(el string, null bool) error {
+		if null {
+			return ErrSQLNullElement
+		}
+		v, err := parseSQLElement[T](el)
+		if err != nil {
+			return err
+		}
+		set.Add(v)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.Set = set
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (s SQLSet[T]) Value() (driver.Value, error) {
+	if s.Set == nil {
+		return nil, nil
+	}
+	sl := s.Set.ToSlice()
+	sort.Slice(sl, func(i, j int) bool { return sl[i] < sl[j] })
+	return sqlValue(sl, s.Format)
+}
+
+// SQLSlice wraps a Slice so it can be used directly as a query argument and
+// as a Scan destination with database/sql. A SQL NULL is read as a nil Slice,
+// and a nil Slice is written as SQL NULL. NULL elements inside the array can't
+// be represented in a Slice, so scanning them returns ErrSQLNullElement.
+type SQLSlice[T SQLElement] struct {
+	Slice  Slice[T]
+	Format SQLArrayFormat
+}
+
+// Scan implements sql.Scanner.
+func (s *SQLSlice[T]) Scan(src any) error {
+	raw, isNull, err := sqlSource(src, "SQLSlice")
+	if err != nil {
+		return err
+	}
+	if isNull {
+		s.Slice = nil
+		return nil
+	}
+	if s.Format == SQLArrayJSON {
+		sl, err := parseJSONArray[T](raw)
+		if err != nil {
+			return err
+		}
+		s.Slice = sl
+		return nil
+	}
+	sl := Slice[T]{}
+	err = parsePostgresArray(string(raw), func(el string, null bool) error {
+		if null {
+			return ErrSQLNullElement
+		}
+		v, err := parseSQLElement[T](el)
+		if err != nil {
+			return err
+		}
+		sl = append(sl, v)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.Slice = sl
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (s SQLSlice[T]) Value() (driver.Value, error) {
+	if s.Slice == nil {
+		return nil, nil
+	}
+	return sqlValue(s.Slice, s.Format)
+}
+
+func sqlSource(src any, typeName string) ([]byte, bool, error) {
+	switch v := src.(type) {
+	case nil:
+		return nil, true, nil
+	case []byte:
+		return v, false, nil
+	case string:
+		return []byte(v), false, nil
+	default:
+		return nil, false, fmt.Errorf("collections: cannot scan %T into %s", src, typeName)
+	}
+}
+
+func sqlValue[T SQLElement](sl []T, format SQLArrayFormat) (driver.Value, error) {
+	if format == SQLArrayJSON {
+		b, err := json.Marshal(sl)
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+	}
+	return formatPostgresArray(sl), nil
+}
+
+// parseJSONArray decodes a JSON array, returning nil for a JSON null. The
+// elements are decoded through pointers so that null elements can be told
+// apart from zero values.
+func parseJSONArray[T SQLElement](raw []byte) ([]T, error) {
+	var ptrs []*T
+	if err := json.Unmarshal(raw, &ptrs); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSQLArray, err)
+	}
+	if ptrs == nil {
+		return nil, nil
+	}
+	sl := make([]T, len(ptrs))
+	for i, p := range ptrs {
+		if p == nil {
+			return nil, ErrSQLNullElement
+		}
+		sl[i] = *p
+	}
+	return sl, nil
+}
+
+func parseSQLElement[T SQLElement](s string) (T, error) {
+	var el T
+	switch p := any(&el).(type) {
+	case *string:
+		*p = s
+	case *int64:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return el, fmt.Errorf("%w: %v", ErrInvalidSQLArray, err)
+		}
+		*p = n
+	}
+	return el, nil
+}
+
+func formatPostgresArray[T SQLElement](sl []T) string {
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i := 0; i < len(sl); i++ {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		switch v := any(sl[i]).(type) {
+		case string:
+			writePostgresString(&sb, v)
+		case int64:
+			sb.WriteString(strconv.FormatInt(v, 10))
+		}
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+// Strings are only quoted when PostgreSQL would require it, which matches
+// the way the server itself prints arrays.
+func writePostgresString(sb *strings.Builder, s string) {
+	if !postgresNeedsQuotes(s) {
+		sb.WriteString(s)
+		return
+	}
+	sb.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		if s[i] == '"' || s[i] == '\\' {
+			sb.WriteByte('\\')
+		}
+		sb.WriteByte(s[i])
+	}
+	sb.WriteByte('"')
+}
+
+func postgresNeedsQuotes(s string) bool {
+	if s == "" || strings.EqualFold(s, "NULL") {
+		return true
+	}
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '{', '}', ',', '"', '\\', ' ', '\t', '\n', '\r', '\v', '\f':
+			return true
+		}
+	}
+	return false
+}
+
+func isPostgresSpace(c byte) bool {
+	switch c {
+	case ' ', '\t', '\n', '\r', '\v', '\f':
+		return true
+	}
+	return false
+}
+
+// parsePostgresArray walks a one-dimensional PostgreSQL array literal and
+// calls emit for each element. Unquoted elements have surrounding whitespace
+// removed, and an unquoted NULL is reported as a null element.
+func parsePostgresArray(s string, emit func(el string, null bool) error) error {
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return fmt.Errorf("%w: %q", ErrInvalidSQLArray, s)
+	}
+	body := s[1 : len(s)-1]
+	if strings.TrimSpace(body) == "" {
+		return nil
+	}
+
+	var sb strings.Builder
+	i := 0
+	for {
+		for i < len(body) && isPostgresSpace(body[i]) {
+			i++
+		}
+		if i == len(body) {
+			return fmt.Errorf("%w: missing element in %q", ErrInvalidSQLArray, s)
+		}
+
+		sb.Reset()
+		null := false
+		switch body[i] {
+		case '{':
+			return fmt.Errorf("%w: multidimensional arrays are not supported", ErrInvalidSQLArray)
+		case '"':
+			i++
+			closed := false
+			for i < len(body) && !closed {
+				switch body[i] {
+				case '\\':
+					i++
+					if i == len(body) {
+						return fmt.Errorf("%w: unterminated escape in %q", ErrInvalidSQLArray, s)
+					}
+					sb.WriteByte(body[i])
+				case '"':
+					closed = true
+				default:
+					sb.WriteByte(body[i])
+				}
+				i++
+			}
+			if !closed {
+				return fmt.Errorf("%w: unterminated quote in %q", ErrInvalidSQLArray, s)
+			}
+		default:
+			// keep tracks the length up to the last escaped character, since
+			// escaped whitespace must survive the trailing whitespace trim.
+			keep := 0
+			escaped := false
+			for i < len(body) && body[i] != ',' {
+				switch body[i] {
+				case '\\':
+					i++
+					if i == len(body) {
+						return fmt.Errorf("%w: unterminated escape in %q", ErrInvalidSQLArray, s)
+					}
+					sb.WriteByte(body[i])
+					keep = sb.Len()
+					escaped = true
+				case '"', '{', '}':
+					return fmt.Errorf("%w: unexpected %q in %q", ErrInvalidSQLArray, body[i], s)
+				default:
+					sb.WriteByte(body[i])
+				}
+				i++
+			}
+			el := sb.String()
+			end := len(el)
+			for end > keep && isPostgresSpace(el[end-1]) {
+				end--
+			}
+			if end == 0 && !escaped {
+				return fmt.Errorf("%w: missing element in %q", ErrInvalidSQLArray, s)
+			}
+			sb.Reset()
+			sb.WriteString(el[:end])
+			null = !escaped && strings.EqualFold(el[:end], "NULL")
+		}
+		if err := emit(sb.String(), null); err != nil {
+			return err
+		}
+
+		for i < len(body) && isPostgresSpace(body[i]) {
+			i++
+		}
+		if i == len(body) {
+			return nil
+		}
+		if body[i] != ',' {
+			return fmt.Errorf("%w: expected ',' in %q", ErrInvalidSQLArray, s)
+		}
+		i++
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/RageCage64/collections-go"
+	"github.com/RageCage64/go-assert"
+)
+
+// fakeDriver is a single-column store that keeps whatever value was last
+// written with an INSERT, and returns it as []byte (the way PostgreSQL
+// drivers do) from a SELECT. Any other query is rejected.
+type fakeDriver struct {
+	mu     sync.Mutex
+	stored driver.Value
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) { return &fakeConn{d: d}, nil }
+
+type fakeConn struct{ d *fakeDriver }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{d: c.d, query: query}, nil
+}
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return nil, errors.New("not supported") }
+
+type fakeStmt struct {
+	d     *fakeDriver
+	query string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if s.query != "INSERT" || len(args) != 1 {
+		return nil, errors.New("unexpected exec")
+	}
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+	s.d.stored = args[0]
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	if s.query != "SELECT" {
+		return nil, errors.New("unexpected query")
+	}
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+	v := s.d.stored
+	if str, ok := v.(string); ok {
+		v = []byte(str)
+	}
+	return &fakeRows{value: v}, nil
+}
+
+type fakeRows struct {
+	value driver.Value
+	done  bool
+}
+
+func (r *fakeRows) Columns() []string { return []string{"value"} }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = r.value
+	return nil
+}
+
+var (
+	fakeDB     *fakeDriver
+	fakeDBOnce sync.Once
+)
+
+func openFakeDB(t *testing.T) (*sql.DB, *fakeDriver) {
+	t.Helper()
+
+	fakeDBOnce.Do(func() {
+		fakeDB = &fakeDriver{}
+		sql.Register("collections-fake", fakeDB)
+	})
+	db, err := sql.Open("collections-fake", "")
+	assert.NilErr(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db, fakeDB
+}
+
+func roundTrip[T any](t *testing.T, db *sql.DB, in driver.Valuer, out T) {
+	t.Helper()
+
+	_, err := db.Exec("INSERT", in)
+	assert.NilErr(t, err)
+	assert.NilErr(t, db.QueryRow("SELECT").Scan(out))
+}
+
+func TestSQLSetRoundTrip(t *testing.T) {
+	db, d := openFakeDB(t)
+
+	in := collections.SQLSet[string]{
+		Set: collections.NewSet("b", "a c", `q"uote`, "NULL", ""),
+	}
+	out := &collections.SQLSet[string]{}
+	roundTrip(t, db, in, out)
+	assert.Equal(t, `{"","NULL","a c",b,"q\"uote"}`, d.stored.(string))
+	assert.Assert(
+		t,
+		in.Set.Equals(out.Set),
+		"expected %v, got %v", in.Set, out.Set,
+	)
+}
+
+func TestSQLSliceRoundTrip(t *testing.T) {
+	db, d := openFakeDB(t)
+
+	in := collections.SQLSlice[int64]{Slice: collections.Slice[int64]{3, -1, 3}}
+	out := &collections.SQLSlice[int64]{}
+	roundTrip(t, db, in, out)
+	assert.Equal(t, "{3,-1,3}", d.stored.(string))
+	assert.SliceEqual(t, in.Slice, out.Slice)
+}
+
+func TestSQLSliceJSONRoundTrip(t *testing.T) {
+	db, d := openFakeDB(t)
+
+	in := collections.SQLSlice[string]{
+		Slice:  collections.Slice[string]{"x", `y"z`},
+		Format: collections.SQLArrayJSON,
+	}
+	out := &collections.SQLSlice[string]{Format: collections.SQLArrayJSON}
+	roundTrip(t, db, in, out)
+	assert.Equal(t, `["x","y\"z"]`, d.stored.(string))
+	assert.SliceEqual(t, in.Slice, out.Slice)
+}
+
+func TestSQLNull(t *testing.T) {
+	db, d := openFakeDB(t)
+
+	out := &collections.SQLSet[int64]{Set: collections.NewSet[int64](1)}
+	roundTrip(t, db, collections.SQLSet[int64]{}, out)
+	assert.Assert(t, d.stored == nil, "expected NULL, got %v", d.stored)
+	assert.Assert(t, out.Set == nil, "expected nil set, got %v", out.Set)
+}
+
+func TestSQLSliceScan(t *testing.T) {
+	testCases := []struct {
+		name     string
+		src      string
+		expected []string
+	}{
+		{"empty", "{}", []string{}},
+		{"unquoted", "{a,b}", []string{"a", "b"}},
+		{"whitespace", "{ a b , c }", []string{"a b", "c"}},
+		{"quoted", `{"a,b","{}"," "}`, []string{"a,b", "{}", " "}},
+		{"escapes", `{"a\"b","c\\d",e\,f}`, []string{`a"b`, `c\d`, "e,f"}},
+		{"quoted null", `{"NULL"}`, []string{"NULL"}},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var sl collections.SQLSlice[string]
+			assert.NilErr(t, sl.Scan(tc.src))
+			assert.SliceEqual(t, tc.expected, sl.Slice)
+		})
+	}
+}
+
+func TestSQLSliceScanErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		format   collections.SQLArrayFormat
+		src      string
+		expected error
+	}{
+		{"null element", collections.SQLArrayPostgres, "{a,NULL}", collections.ErrSQLNullElement},
+		{"no braces", collections.SQLArrayPostgres, "a,b", collections.ErrInvalidSQLArray},
+		{"unterminated quote", collections.SQLArrayPostgres, `{"a}`, collections.ErrInvalidSQLArray},
+		{"missing element", collections.SQLArrayPostgres, "{a,,b}", collections.ErrInvalidSQLArray},
+		{"trailing comma", collections.SQLArrayPostgres, "{a,}", collections.ErrInvalidSQLArray},
+		{"multidimensional", collections.SQLArrayPostgres, "{{a},{b}}", collections.ErrInvalidSQLArray},
+		{"json null element", collections.SQLArrayJSON, `["a",null]`, collections.ErrSQLNullElement},
+		{"json syntax", collections.SQLArrayJSON, `["a",`, collections.ErrInvalidSQLArray},
+		{"json not an array", collections.SQLArrayJSON, `{"a":1}`, collections.ErrInvalidSQLArray},
+		{"json wrong element type", collections.SQLArrayJSON, `["a",1]`, collections.ErrInvalidSQLArray},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			sl := collections.SQLSlice[string]{Format: tc.format}
+			err := sl.Scan(tc.src)
+			assert.Assert(
+				t,
+				errors.Is(err, tc.expected),
+				"expected %v, got %v", tc.expected, err,
+			)
+		})
+	}
+}
+
+func TestSQLScanJSONNullInt(t *testing.T) {
+	sl := collections.SQLSlice[int64]{Format: collections.SQLArrayJSON}
+	err := sl.Scan([]byte("[1,null,3]"))
+	assert.Assert(
+		t,
+		errors.Is(err, collections.ErrSQLNullElement),
+		"expected null element error, got %v", err,
+	)
+
+	set := collections.SQLSet[int64]{Format: collections.SQLArrayJSON}
+	err = set.Scan([]byte("[1,null,3]"))
+	assert.Assert(
+		t,
+		errors.Is(err, collections.ErrSQLNullElement),
+		"expected null element error, got %v", err,
+	)
+}
+
+func TestSQLSliceScanInvalidInt(t *testing.T) {
+	var sl collections.SQLSlice[int64]
+	err := sl.Scan([]byte("{1,x}"))
+	assert.Assert(
+		t,
+		errors.Is(err, collections.ErrInvalidSQLArray),
+		"expected invalid array error, got %v", err,
+	)
+}
@@ -0,0 +1,394 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections
+
+// Stream is a lazy pipeline of operations over a sequence of elements.
+// Building a Stream does no work; the stages are only run when a terminal
+// operation such as Collect, Reduce or Count is called. At that point every
+// stage is fused into a single pass over the source, so unlike chaining the
+// eager Slice methods, no intermediate slices are allocated. Running a stream
+// allocates a small, fixed number of closures no matter how many elements it
+// has.
+//
+// A Stream can be run more than once if its source can be. Streams built
+// from a generator function with StreamFromFunc are single use, since the
+// generator is consumed by the first run. The zero value is an empty stream.
+type Stream[T any] struct {
+	seq func(yield func(T) bool)
+	// size is the exact number of elements the stream will produce, or -1
+	// if that is unknown, such as after a stage that may drop elements.
+	// Collect uses it to allocate once when it is known.
+	size int
+}
+
+// run passes each element of the stream to yield, treating the zero value
+// Stream as empty.
+func (s Stream[T]) run(yield func(T) bool) {
+	if s.seq != nil {
+		s.seq(yield)
+	}
+}
+
+/*****************
+Sources.
+*****************/
+
+// Create a Stream over the elements of a slice. The slice is not copied, so
+// changes to it before the stream runs are visible to the stream.
+func StreamFromSlice[T any](sl []T) Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			for i := 0; i < len(sl); i++ {
+				if !yield(sl[i]) {
+					return
+				}
+			}
+		},
+		size: len(sl),
+	}
+}
+
+// Create a Stream over the elements of a Set, in no particular order.
+func StreamFromSet[T comparable](set Set[T]) Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			for el := range set {
+				if !yield(el) {
+					return
+				}
+			}
+		},
+		size: len(set),
+	}
+}
+
+// Create a Stream over the elements of a Queue from front to back. The
+// queue is not modified.
+func StreamFromQueue[T any](q *Queue[T]) Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			for curr := q.Head; curr != nil; curr = curr.Next {
+				if !yield(curr.Value) {
+					return
+				}
+			}
+		},
+		size: -1,
+	}
+}
+
+// Create a Stream from a generator function. The generator is called for
+// each element until it returns false. A generator that never returns false
+// produces an infinite stream, which should be limited with Take or
+// TakeWhile before using a terminal operation that consumes every element.
+func StreamFromFunc[T any](gen func() (T, bool)) Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			for {
+				el, ok := gen()
+				if !ok || !yield(el) {
+					return
+				}
+			}
+		},
+		size: -1,
+	}
+}
+
+/*****************
+Intermediate operations. Each returns a new Stream and does no work until a
+terminal operation is called.
+*****************/
+
+// Keep only the elements for which the predicate is true.
+func (s Stream[T]) Filter(pred UnaryPredicate[T]) Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			s.run(func(el T) bool {
+				if pred(el) {
+					return yield(el)
+				}
+				return true
+			})
+		},
+		size: -1,
+	}
+}
+
+// Replace each element with the result of the operator. To map to a
+// different type, use StreamMap.
+func (s Stream[T]) Map(op UnaryOperator[T]) Stream[T] {
+	return StreamMap(s, Mapper[T, T](op))
+}
+
+// Replace each element with the result of the mapper, which may be of a
+// different type.
+func StreamMap[T any, U any](s Stream[T], mapper Mapper[T, U]) Stream[U] {
+	return Stream[U]{
+		seq: func(yield func(U) bool) {
+			s.run(func(el T) bool {
+				return yield(mapper(el))
+			})
+		},
+		size: s.size,
+	}
+}
+
+// Stop the stream after at most n elements.
+func (s Stream[T]) Take(n int) Stream[T] {
+	size := s.size
+	if n <= 0 {
+		size = 0
+	} else if size > n {
+		size = n
+	}
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			if n <= 0 {
+				return
+			}
+			taken := 0
+			s.run(func(el T) bool {
+				taken++
+				return yield(el) && taken < n
+			})
+		},
+		size: size,
+	}
+}
+
+// Drop the first n elements of the stream.
+func (s Stream[T]) Skip(n int) Stream[T] {
+	size := s.size
+	if size > 0 && n > 0 {
+		size -= n
+		if size < 0 {
+			size = 0
+		}
+	}
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			skipped := 0
+			s.run(func(el T) bool {
+				if skipped < n {
+					skipped++
+					return true
+				}
+				return yield(el)
+			})
+		},
+		size: size,
+	}
+}
+
+// Keep elements until the first one for which the predicate is false, then
+// stop the stream.
+func (s Stream[T]) TakeWhile(pred UnaryPredicate[T]) Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			s.run(func(el T) bool {
+				return pred(el) && yield(el)
+			})
+		},
+		size: -1,
+	}
+}
+
+// Drop elements until the first one for which the predicate is false, then
+// keep every element from there on.
+func (s Stream[T]) DropWhile(pred UnaryPredicate[T]) Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			dropping := true
+			s.run(func(el T) bool {
+				if dropping && pred(el) {
+					return true
+				}
+				dropping = false
+				return yield(el)
+			})
+		},
+		size: -1,
+	}
+}
+
+// Run an operation on each element as it passes through the stream, without
+// changing it. Useful for debugging a pipeline.
+func (s Stream[T]) Peek(do UnaryReceiver[T]) Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			s.run(func(el T) bool {
+				do(el)
+				return yield(el)
+			})
+		},
+		size: s.size,
+	}
+}
+
+// Drop any element that has already been seen earlier in the stream. This
+// allocates a Set each time the stream runs to remember the elements seen.
+func StreamDistinct[T comparable](s Stream[T]) Stream[T] {
+	return Stream[T]{
+		seq: func(yield func(T) bool) {
+			seen := Set[T]{}
+			s.run(func(el T) bool {
+				if seen.Contains(el) {
+					return true
+				}
+				seen.Add(el)
+				return yield(el)
+			})
+		},
+		size: -1,
+	}
+}
+
+/*****************
+Terminal operations. Each runs the stream.
+*****************/
+
+// Collect every element of the stream into a new slice.
+//
+// Time Complexity: O(n * m) (where m = combined complexity of the stages)
+// Space Complexity: O(n)
+// Allocations: 1 slice when the exact size of the stream is known, otherwise
+// 1 slice resized as necessary. The size is known for a slice, Set or range
+// source with only Map, Peek, Take and Skip stages after it. After a stage
+// that may drop elements, such as Filter, use AppendTo with a reused buffer
+// to avoid allocating.
+func (s Stream[T]) Collect() []T {
+	var result []T
+	if s.size > 0 {
+		result = make([]T, 0, s.size)
+	}
+	return s.AppendTo(result)
+}
+
+// Append every element of the stream to dst and return the extended slice.
+// With a dst of enough capacity, this allocates nothing.
+//
+// Time Complexity: O(n * m) (where m = combined complexity of the stages)
+// Space Complexity: O(1)
+// Allocations: dst resize if necessary
+func (s Stream[T]) AppendTo(dst []T) []T {
+	s.run(func(el T) bool {
+		dst = append(dst, el)
+		return true
+	})
+	return dst
+}
+
+// Run an operation using each element of the stream.
+//
+// Time Complexity: O(n * m) (where m = combined complexity of the stages)
+// Space Complexity: O(1)
+// Allocations: None per element
+func (s Stream[T]) ForEach(do UnaryReceiver[T]) {
+	s.run(func(el T) bool {
+		do(el)
+		return true
+	})
+}
+
+// With a starting accumulator, run the reducer with the accumulator and each
+// element of the stream. To reduce to a different type, use StreamReduce.
+//
+// Time Complexity: O(n * m) (where m = combined complexity of the stages)
+// Space Complexity: O(1)
+// Allocations: None per element
+func (s Stream[T]) Reduce(accumulator T, reducer Reducer[T, T]) T {
+	return StreamReduce(s, accumulator, reducer)
+}
+
+// With a starting accumulator, run the reducer with the accumulator and each
+// element of the stream.
+//
+// Time Complexity: O(n * m) (where m = combined complexity of the stages)
+// Space Complexity: O(1)
+// Allocations: None per element
+func StreamReduce[Acc any, T any](s Stream[T], accumulator Acc, reducer Reducer[Acc, T]) Acc {
+	s.run(func(el T) bool {
+		accumulator = reducer(accumulator, el)
+		return true
+	})
+	return accumulator
+}
+
+// Count the elements of the stream.
+//
+// Time Complexity: O(n * m) (where m = combined complexity of the stages)
+// Space Complexity: O(1)
+// Allocations: None per element
+func (s Stream[T]) Count() int {
+	n := 0
+	s.run(func(T) bool {
+		n++
+		return true
+	})
+	return n
+}
+
+// Get the first element of the stream, or false if it is empty. Only as
+// much of the source as needed to find the first element is read.
+//
+// Time Complexity: O(n * m) worst case (where m = combined complexity of the stages)
+// Space Complexity: O(1)
+// Allocations: None per element
+func (s Stream[T]) First() (T, bool) {
+	var first T
+	found := false
+	s.run(func(el T) bool {
+		first = el
+		found = true
+		return false
+	})
+	return first, found
+}
+
+// Check if the predicate is true for any element of the stream. Stops at the
+// first element that matches.
+//
+// Time Complexity: O(n * m) worst case (where m = combined complexity of the stages)
+// Space Complexity: O(1)
+// Allocations: None per element
+func (s Stream[T]) AnyMatch(pred UnaryPredicate[T]) bool {
+	matched := false
+	s.run(func(el T) bool {
+		matched = pred(el)
+		return !matched
+	})
+	return matched
+}
+
+// Check if the predicate is true for every element of the stream. Stops at
+// the first element that doesn't match. An empty stream always matches.
+//
+// Time Complexity: O(n * m) worst case (where m = combined complexity of the stages)
+// Space Complexity: O(1)
+// Allocations: None per element
+func (s Stream[T]) AllMatch(pred UnaryPredicate[T]) bool {
+	matched := true
+	s.run(func(el T) bool {
+		matched = pred(el)
+		return matched
+	})
+	return matched
+}
@@ -0,0 +1,242 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/RageCage64/collections-go"
+	"github.com/RageCage64/go-assert"
+)
+
+func isEven(x int) bool { return x%2 == 0 }
+func double(x int) int  { return x * 2 }
+
+func TestStreamFilterMapCollect(t *testing.T) {
+	result := collections.StreamFromSlice(collections.Range(10)).
+		Filter(isEven).
+		Map(double).
+		Collect()
+	assert.SliceEqual(t, []int{0, 4, 8, 12, 16}, result)
+}
+
+func TestStreamIsReusable(t *testing.T) {
+	s := collections.StreamFromSlice([]int{1, 2, 3}).Skip(1)
+	assert.SliceEqual(t, []int{2, 3}, s.Collect())
+	assert.SliceEqual(t, []int{2, 3}, s.Collect())
+}
+
+func TestStreamTakeSkip(t *testing.T) {
+	s := collections.StreamFromSlice(collections.Range(10))
+	assert.SliceEqual(t, []int{2, 3, 4}, s.Skip(2).Take(3).Collect())
+	assert.SliceEqual(t, []int{}, s.Take(0).AppendTo([]int{}))
+	assert.Equal(t, 0, s.Skip(20).Count())
+}
+
+func TestStreamWhile(t *testing.T) {
+	s := collections.StreamFromSlice([]int{1, 2, 3, 10, 1, 2})
+	small := func(x int) bool { return x < 5 }
+	assert.SliceEqual(t, []int{1, 2, 3}, s.TakeWhile(small).Collect())
+	assert.SliceEqual(t, []int{10, 1, 2}, s.DropWhile(small).Collect())
+}
+
+func TestStreamDistinctPeek(t *testing.T) {
+	var peeked []int
+	s := collections.StreamFromSlice([]int{1, 2, 1, 3, 2}).
+		Peek(func(x int) { peeked = append(peeked, x) })
+	result := collections.StreamDistinct(s).Collect()
+	assert.SliceEqual(t, []int{1, 2, 3}, result)
+	assert.SliceEqual(t, []int{1, 2, 1, 3, 2}, peeked)
+}
+
+func TestStreamFromFunc(t *testing.T) {
+	n := 0
+	naturals := collections.StreamFromFunc(func() (int, bool) {
+		n++
+		return n, true
+	})
+	result := naturals.Filter(isEven).Take(3).Collect()
+	assert.SliceEqual(t, []int{2, 4, 6}, result)
+	assert.Equal(t, 6, n)
+}
+
+func TestStreamFromSetAndQueue(t *testing.T) {
+	set := collections.NewSet(1, 2, 3)
+	assert.Equal(t, 6, collections.StreamFromSet(set).Reduce(0, func(acc int, x int) int {
+		return acc + x
+	}))
+
+	q := &collections.Queue[string]{}
+	q.Enqueue("a")
+	q.Enqueue("b")
+	assert.SliceEqual(t, []string{"a", "b"}, collections.StreamFromQueue(q).Collect())
+	assert.Equal(t, 2, q.Head.Len())
+}
+
+func TestStreamTerminals(t *testing.T) {
+	s := collections.StreamFromSlice([]int{1, 3, 4, 5})
+	first, ok := s.Filter(isEven).First()
+	assert.Assert(t, ok, "expected to find an even element")
+	assert.Equal(t, 4, first)
+	_, ok = s.Filter(func(x int) bool { return x > 10 }).First()
+	assert.Assert(t, !ok, "expected no element greater than 10")
+
+	assert.Assert(t, s.AnyMatch(isEven), "expected an even element")
+	assert.Assert(t, !s.AllMatch(isEven), "expected an odd element")
+	assert.Assert(t, s.Take(0).AllMatch(isEven), "expected empty stream to match")
+	assert.Equal(t, 4, s.Count())
+
+	strs := collections.StreamMap(s, strconv.Itoa).Collect()
+	assert.SliceEqual(t, []string{"1", "3", "4", "5"}, strs)
+	joined := collections.StreamReduce(s, "", func(acc string, x int) string {
+		return acc + strconv.Itoa(x)
+	})
+	assert.Equal(t, "1345", joined)
+}
+
+func TestStreamZeroValue(t *testing.T) {
+	var s collections.Stream[int]
+	assert.Equal(t, 0, len(s.Collect()))
+	assert.Equal(t, 0, s.Count())
+	_, ok := s.First()
+	assert.Assert(t, !ok, "expected no element in a zero value stream")
+	assert.Assert(t, !s.AnyMatch(isEven), "expected no element in a zero value stream")
+	assert.Equal(t, 0, s.Filter(isEven).Take(3).Count())
+	assert.Equal(t, 0, collections.StreamReduce(s, 0, func(acc int, x int) int { return acc + x }))
+}
+
+// The number of allocations made by a stream should not depend on how many
+// elements pass through it, since no intermediate slices are built.
+func TestStreamAllocationsAreConstant(t *testing.T) {
+	allocs := func(n int) float64 {
+		input := collections.Range(n)
+		buf := make([]int, 0, n)
+		return testing.AllocsPerRun(10, func() {
+			_ = collections.StreamFromSlice(input).Filter(isEven).Map(double).Count()
+			_ = collections.StreamFromSlice(input).Map(double).Skip(1).Collect()
+			buf = collections.StreamFromSlice(input).Filter(isEven).Map(double).AppendTo(buf[:0])
+		})
+	}
+	small, large := allocs(10), allocs(10_000)
+	assert.Assert(
+		t,
+		small == large,
+		"expected a constant number of allocations, got %v for 10 elements and %v for 10000",
+		small, large,
+	)
+}
+
+// Collect should only size its result from the source when no stage can
+// drop elements, so a selective filter doesn't allocate for the whole source.
+func TestStreamCollectCapacity(t *testing.T) {
+	input := collections.Range(1000)
+	mapped := collections.StreamFromSlice(input).Map(double).Take(10).Collect()
+	assert.Equal(t, 10, len(mapped))
+	assert.Equal(t, 10, cap(mapped))
+
+	isSmall := func(x int) bool { return x < 3 }
+	stages := map[string]collections.Stream[int]{
+		"filter":     collections.StreamFromSlice(input).Filter(isSmall),
+		"take while": collections.StreamFromSlice(input).TakeWhile(isSmall),
+		"drop while": collections.StreamFromSlice(input).DropWhile(func(x int) bool { return x >= 3 }).Take(3),
+		"distinct":   collections.StreamDistinct(collections.StreamFromSlice(make([]int, 1000))),
+	}
+	for name, s := range stages {
+		t.Run(name, func(t *testing.T) {
+			result := s.Collect()
+			assert.Assert(t, cap(result) < 100, "expected a small result, got capacity %d", cap(result))
+		})
+	}
+}
+
+var benchInput = collections.Range(100_000)
+
+func BenchmarkEagerFilterMapCollect(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = benchInput.Filter(isEven).Map(double)
+	}
+}
+
+func BenchmarkStreamFilterMapCollect(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = collections.StreamFromSlice(benchInput).Filter(isEven).Map(double).Collect()
+	}
+}
+
+func BenchmarkEagerFilterMapCount(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = len(benchInput.Filter(isEven).Map(double))
+	}
+}
+
+func BenchmarkStreamFilterMapCount(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = collections.StreamFromSlice(benchInput).Filter(isEven).Map(double).Count()
+	}
+}
+
+func BenchmarkEagerFilterFirst(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = benchInput.Filter(func(x int) bool { return x > 10 })[0]
+	}
+}
+
+func BenchmarkStreamFilterFirst(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = collections.StreamFromSlice(benchInput).Filter(func(x int) bool { return x > 10 }).First()
+	}
+}
+
+// When only part of the result is needed, or a filter keeps few elements,
+// the stream allocates for what it produces rather than for the whole input.
+func BenchmarkEagerFilterMapTake(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = benchInput.Filter(isEven).Map(double)[:10]
+	}
+}
+
+func BenchmarkStreamFilterMapTake(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = collections.StreamFromSlice(benchInput).Filter(isEven).Map(double).Take(10).Collect()
+	}
+}
+
+func BenchmarkEagerSelectiveFilterCollect(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = benchInput.Filter(func(x int) bool { return x%1000 == 0 })
+	}
+}
+
+func BenchmarkStreamSelectiveFilterCollect(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = collections.StreamFromSlice(benchInput).Filter(func(x int) bool { return x%1000 == 0 }).Collect()
+	}
+}
@@ -0,0 +1,480 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections
+
+// TreeMap is a map that keeps its keys sorted, using a comparator. It is an
+// AVL tree where each node also records the size of its subtree, so as well
+// as O(log n) lookups, inserts and deletes, it can find the k-th smallest
+// key or the rank of a key in O(log n).
+//
+// A TreeMap is not safe for concurrent use.
+type TreeMap[K any, V any] struct {
+	root *treeNode[K, V]
+	cmp  CompareFunc[K]
+}
+
+type treeNode[K any, V any] struct {
+	key         K
+	value       V
+	left, right *treeNode[K, V]
+	height      int
+	size        int
+}
+
+// Initialize a new, empty TreeMap ordered by cmp.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func NewTreeMap[K any, V any](cmp CompareFunc[K]) *TreeMap[K, V] {
+	return &TreeMap[K, V]{cmp: cmp}
+}
+
+// Initialize a new, empty TreeMap ordered by the natural order of its keys.
+// Take care with floating point keys, since NaN is not ordered relative to
+// any other value.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func NewOrderedTreeMap[K Ordered, V any]() *TreeMap[K, V] {
+	return NewTreeMap[K, V](func(a K, b K) int {
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	})
+}
+
+// Get the number of entries in the map.
+//
+// Time Complexity: O(1)
+// Space Complexity: O(1)
+// Allocations: None
+func (t *TreeMap[K, V]) Len() int {
+	return t.root.sizeOf()
+}
+
+// Set the value for a key, replacing any value already there.
+//
+// Time Complexity: O(log(n))
+// Space Complexity: O(log(n))
+// Allocations: 1 node for a new key.
+func (t *TreeMap[K, V]) Put(key K, value V) {
+	t.root = t.put(t.root, key, value)
+}
+
+func (t *TreeMap[K, V]) put(n *treeNode[K, V], key K, value V) *treeNode[K, V] {
+	if n == nil {
+		return &treeNode[K, V]{key: key, value: value, height: 1, size: 1}
+	}
+	switch c := t.cmp(key, n.key); {
+	case c < 0:
+		n.left = t.put(n.left, key, value)
+	case c > 0:
+		n.right = t.put(n.right, key, value)
+	default:
+		n.value = value
+		return n
+	}
+	return n.rebalance()
+}
+
+// Get the value for a key, and whether the key was present.
+//
+// Time Complexity: O(log(n))
+// Space Complexity: O(1)
+// Allocations: None
+func (t *TreeMap[K, V]) Get(key K) (V, bool) {
+	n := t.root
+	for n != nil {
+		switch c := t.cmp(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.value, true
+		}
+	}
+	return *new(V), false
+}
+
+// Check if the map contains a key.
+//
+// Time Complexity: O(log(n))
+// Space Complexity: O(1)
+// Allocations: None
+func (t *TreeMap[K, V]) Has(key K) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Remove a key from the map. Returns false if the key was not present.
+//
+// Time Complexity: O(log(n))
+// Space Complexity: O(log(n))
+// Allocations: None
+func (t *TreeMap[K, V]) Delete(key K) bool {
+	var deleted bool
+	t.root, deleted = t.delete(t.root, key)
+	return deleted
+}
+
+func (t *TreeMap[K, V]) delete(n *treeNode[K, V], key K) (*treeNode[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+	var deleted bool
+	switch c := t.cmp(key, n.key); {
+	case c < 0:
+		n.left, deleted = t.delete(n.left, key)
+	case c > 0:
+		n.right, deleted = t.delete(n.right, key)
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+		// Replace the node with its successor, the smallest node on the right.
+		var successor *treeNode[K, V]
+		n.right, successor = n.right.deleteMin()
+		successor.left, successor.right = n.left, n.right
+		return successor.rebalance(), true
+	}
+	if !deleted {
+		return n, false
+	}
+	return n.rebalance(), true
+}
+
+// Get the entry with the smallest key. Returns false if the map is empty.
+//
+// Time Complexity: O(log(n))
+// Space Complexity: O(1)
+// Allocations: None
+func (t *TreeMap[K, V]) Min() (K, V, bool) {
+	if t.root == nil {
+		return *new(K), *new(V), false
+	}
+	n := t.root
+	for n.left != nil {
+		n = n.left
+	}
+	return n.key, n.value, true
+}
+
+// Get the entry with the largest key. Returns false if the map is empty.
+//
+// Time Complexity: O(log(n))
+// Space Complexity: O(1)
+// Allocations: None
+func (t *TreeMap[K, V]) Max() (K, V, bool) {
+	if t.root == nil {
+		return *new(K), *new(V), false
+	}
+	n := t.root
+	for n.right != nil {
+		n = n.right
+	}
+	return n.key, n.value, true
+}
+
+// Remove the entry with the smallest key and return it. Returns false if the
+// map is empty.
+//
+// Time Complexity: O(log(n))
+// Space Complexity: O(log(n))
+// Allocations: None
+func (t *TreeMap[K, V]) DeleteMin() (K, V, bool) {
+	if t.root == nil {
+		return *new(K), *new(V), false
+	}
+	var min *treeNode[K, V]
+	t.root, min = t.root.deleteMin()
+	return min.key, min.value, true
+}
+
+// Get the entry with the largest key less than or equal to key. Returns
+// false if there is none.
+//
+// Time Complexity: O(log(n))
+// Space Complexity: O(1)
+// Allocations: None
+func (t *TreeMap[K, V]) Floor(key K) (K, V, bool) {
+	var best *treeNode[K, V]
+	n := t.root
+	for n != nil {
+		c := t.cmp(key, n.key)
+		if c == 0 {
+			return n.key, n.value, true
+		}
+		if c < 0 {
+			n = n.left
+		} else {
+			best = n
+			n = n.right
+		}
+	}
+	if best == nil {
+		return *new(K), *new(V), false
+	}
+	return best.key, best.value, true
+}
+
+// Get the entry with the smallest key greater than or equal to key. Returns
+// false if there is none.
+//
+// Time Complexity: O(log(n))
+// Space Complexity: O(1)
+// Allocations: None
+func (t *TreeMap[K, V]) Ceiling(key K) (K, V, bool) {
+	var best *treeNode[K, V]
+	n := t.root
+	for n != nil {
+		c := t.cmp(key, n.key)
+		if c == 0 {
+			return n.key, n.value, true
+		}
+		if c > 0 {
+			n = n.right
+		} else {
+			best = n
+			n = n.left
+		}
+	}
+	if best == nil {
+		return *new(K), *new(V), false
+	}
+	return best.key, best.value, true
+}
+
+// Call fn with each entry whose key is in [lo, hi), in ascending order,
+// stopping early if it returns false. The map must not be modified inside
+// fn.
+//
+// Time Complexity: O(log(n) + k * m) (where k = entries in range, m = complexity of fn)
+// Space Complexity: O(log(n))
+// Allocations: None
+func (t *TreeMap[K, V]) Range(lo K, hi K, fn func(key K, value V) bool) {
+	t.ascend(t.root, lo, hi, fn)
+}
+
+func (t *TreeMap[K, V]) ascend(n *treeNode[K, V], lo K, hi K, fn func(key K, value V) bool) bool {
+	if n == nil {
+		return true
+	}
+	aboveLo := t.cmp(n.key, lo) >= 0
+	belowHi := t.cmp(n.key, hi) < 0
+	if aboveLo && !t.ascend(n.left, lo, hi, fn) {
+		return false
+	}
+	if aboveLo && belowHi && !fn(n.key, n.value) {
+		return false
+	}
+	if belowHi {
+		return t.ascend(n.right, lo, hi, fn)
+	}
+	return true
+}
+
+// Call fn with each entry whose key is in [lo, hi), in descending order,
+// stopping early if it returns false. The map must not be modified inside
+// fn.
+//
+// Time Complexity: O(log(n) + k * m) (where k = entries in range, m = complexity of fn)
+// Space Complexity: O(log(n))
+// Allocations: None
+func (t *TreeMap[K, V]) RangeReverse(lo K, hi K, fn func(key K, value V) bool) {
+	t.descend(t.root, lo, hi, fn)
+}
+
+func (t *TreeMap[K, V]) descend(n *treeNode[K, V], lo K, hi K, fn func(key K, value V) bool) bool {
+	if n == nil {
+		return true
+	}
+	aboveLo := t.cmp(n.key, lo) >= 0
+	belowHi := t.cmp(n.key, hi) < 0
+	if belowHi && !t.descend(n.right, lo, hi, fn) {
+		return false
+	}
+	if aboveLo && belowHi && !fn(n.key, n.value) {
+		return false
+	}
+	if aboveLo {
+		return t.descend(n.left, lo, hi, fn)
+	}
+	return true
+}
+
+// Call fn with every entry in ascending order, stopping early if it returns
+// false. The map must not be modified inside fn.
+//
+// Time Complexity: O(n * m) (where m = complexity of fn)
+// Space Complexity: O(log(n))
+// Allocations: None
+func (t *TreeMap[K, V]) ForEach(fn func(key K, value V) bool) {
+	t.root.inOrder(fn)
+}
+
+// Create a new slice with every key in ascending order.
+//
+// Time Complexity: O(n)
+// Space Complexity: O(n)
+// Allocations: 1 slice, n elements.
+func (t *TreeMap[K, V]) Keys() []K {
+	keys := make([]K, 0, t.Len())
+	t.ForEach(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Get the number of keys less than key. If key is in the map, this is its
+// 0-based position in ascending order.
+//
+// Time Complexity: O(log(n))
+// Space Complexity: O(1)
+// Allocations: None
+func (t *TreeMap[K, V]) Rank(key K) int {
+	rank := 0
+	n := t.root
+	for n != nil {
+		switch c := t.cmp(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			rank += n.left.sizeOf() + 1
+			n = n.right
+		default:
+			return rank + n.left.sizeOf()
+		}
+	}
+	return rank
+}
+
+// Get the entry with the i-th smallest key, counting from 0. Returns false
+// if i is out of bounds.
+//
+// Time Complexity: O(log(n))
+// Space Complexity: O(1)
+// Allocations: None
+func (t *TreeMap[K, V]) Select(i int) (K, V, bool) {
+	if i < 0 || i >= t.Len() {
+		return *new(K), *new(V), false
+	}
+	n := t.root
+	for {
+		leftSize := n.left.sizeOf()
+		switch {
+		case i < leftSize:
+			n = n.left
+		case i > leftSize:
+			i -= leftSize + 1
+			n = n.right
+		default:
+			return n.key, n.value, true
+		}
+	}
+}
+
+func (n *treeNode[K, V]) heightOf() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *treeNode[K, V]) sizeOf() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func (n *treeNode[K, V]) update() {
+	n.height = n.left.heightOf() + 1
+	if h := n.right.heightOf() + 1; h > n.height {
+		n.height = h
+	}
+	n.size = n.left.sizeOf() + n.right.sizeOf() + 1
+}
+
+func (n *treeNode[K, V]) rotateLeft() *treeNode[K, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	n.update()
+	r.update()
+	return r
+}
+
+func (n *treeNode[K, V]) rotateRight() *treeNode[K, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	n.update()
+	l.update()
+	return l
+}
+
+// rebalance restores the AVL property at n after one of its subtrees has
+// changed height by at most one, and returns the new root of the subtree.
+func (n *treeNode[K, V]) rebalance() *treeNode[K, V] {
+	n.update()
+	balance := n.left.heightOf() - n.right.heightOf()
+	if balance > 1 {
+		if n.left.left.heightOf() < n.left.right.heightOf() {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	}
+	if balance < -1 {
+		if n.right.right.heightOf() < n.right.left.heightOf() {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
+
+// deleteMin removes the smallest node from the subtree, returning the new
+// root of the subtree and the removed node.
+func (n *treeNode[K, V]) deleteMin() (*treeNode[K, V], *treeNode[K, V]) {
+	if n.left == nil {
+		return n.right, n
+	}
+	var min *treeNode[K, V]
+	n.left, min = n.left.deleteMin()
+	return n.rebalance(), min
+}
+
+func (n *treeNode[K, V]) inOrder(fn func(key K, value V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return n.left.inOrder(fn) && fn(n.key, n.value) && n.right.inOrder(fn)
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2023 Braydon Kains
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package collections_test
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/RageCage64/collections-go"
+	"github.com/RageCage64/go-assert"
+)
+
+func TestTreeMap(t *testing.T) {
+	m := collections.NewOrderedTreeMap[int, string]()
+	for _, k := range []int{50, 20, 80, 10, 30, 70, 90} {
+		m.Put(k, "")
+	}
+	m.Put(30, "thirty")
+	assert.Equal(t, 7, m.Len())
+	assert.SliceEqual(t, []int{10, 20, 30, 50, 70, 80, 90}, m.Keys())
+
+	v, ok := m.Get(30)
+	assert.Assert(t, ok, "expected 30 to be present")
+	assert.Equal(t, "thirty", v)
+	assert.Assert(t, !m.Has(31), "expected 31 not to be present")
+
+	k, _, _ := m.Min()
+	assert.Equal(t, 10, k)
+	k, _, _ = m.Max()
+	assert.Equal(t, 90, k)
+
+	k, _, ok = m.Floor(55)
+	assert.Assert(t, ok, "expected a floor of 55")
+	assert.Equal(t, 50, k)
+	k, _, _ = m.Floor(50)
+	assert.Equal(t, 50, k)
+	_, _, ok = m.Floor(5)
+	assert.Assert(t, !ok, "expected no floor of 5")
+
+	k, _, ok = m.Ceiling(55)
+	assert.Assert(t, ok, "expected a ceiling of 55")
+	assert.Equal(t, 70, k)
+	_, _, ok = m.Ceiling(95)
+	assert.Assert(t, !ok, "expected no ceiling of 95")
+
+	assert.Equal(t, 2, m.Rank(30))
+	assert.Equal(t, 3, m.Rank(31))
+	k, _, _ = m.Select(2)
+	assert.Equal(t, 30, k)
+	_, _, ok = m.Select(7)
+	assert.Assert(t, !ok, "expected no 7th key")
+
+	k, _, _ = m.DeleteMin()
+	assert.Equal(t, 10, k)
+	assert.Assert(t, m.Delete(50), "expected 50 to be deleted")
+	assert.Assert(t, !m.Delete(50), "expected 50 to already be deleted")
+	assert.SliceEqual(t, []int{20, 30, 70, 80, 90}, m.Keys())
+}
+
+func TestTreeMapEmpty(t *testing.T) {
+	m := collections.NewOrderedTreeMap[int, int]()
+	_, _, ok := m.Min()
+	assert.Assert(t, !ok, "expected no minimum")
+	_, _, ok = m.Max()
+	assert.Assert(t, !ok, "expected no maximum")
+	_, _, ok = m.DeleteMin()
+	assert.Assert(t, !ok, "expected nothing to delete")
+	assert.Equal(t, 0, m.Rank(1))
+}
+
+func TestTreeMapRange(t *testing.T) {
+	m := collections.NewOrderedTreeMap[int, int]()
+	for _, k := range collections.Range(20) {
+		m.Put(k, k*k)
+	}
+
+	var keys []int
+	m.Range(5, 10, func(k int, v int) bool {
+		assert.Equal(t, k*k, v)
+		keys = append(keys, k)
+		return true
+	})
+	assert.SliceEqual(t, []int{5, 6, 7, 8, 9}, keys)
+
+	keys = nil
+	m.RangeReverse(5, 10, func(k int, _ int) bool {
+		keys = append(keys, k)
+		return k != 7
+	})
+	assert.SliceEqual(t, []int{9, 8, 7}, keys)
+
+	keys = nil
+	m.Range(10, 5, func(k int, _ int) bool {
+		keys = append(keys, k)
+		return true
+	})
+	assert.Equal(t, 0, len(keys))
+}
+
+func TestTreeMapComparator(t *testing.T) {
+	m := collections.NewTreeMap[string, int](func(a string, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	m.Put("b", 1)
+	m.Put("A", 2)
+	m.Put("B", 3)
+	assert.SliceEqual(t, []string{"A", "b"}, m.Keys())
+	v, _ := m.Get("a")
+	assert.Equal(t, 2, v)
+	v, _ = m.Get("b")
+	assert.Equal(t, 3, v)
+}
+
+func TestTreeMapRandomized(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	m := collections.NewOrderedTreeMap[int, int]()
+	ref := map[int]int{}
+
+	for i := 0; i < 5000; i++ {
+		k := rng.Intn(200)
+		switch op := rng.Intn(10); {
+		case op < 5:
+			m.Put(k, i)
+			ref[k] = i
+		case op < 8:
+			_, existed := ref[k]
+			assert.Equal(t, existed, m.Delete(k))
+			delete(ref, k)
+		default:
+			minKey, _, ok := m.DeleteMin()
+			if len(ref) > 0 {
+				expected, _ := collections.SliceMin(collections.MapKeys(ref))
+				assert.Equal(t, expected, minKey)
+				delete(ref, minKey)
+			} else {
+				assert.Assert(t, !ok, "expected nothing to delete")
+			}
+		}
+		assert.NilErr(t, m.CheckInvariants())
+		assert.Equal(t, len(ref), m.Len())
+	}
+
+	keys := collections.MapKeysSorted(ref)
+	assert.SliceEqual(t, keys, m.Keys())
+	for i, k := range keys {
+		v, _ := m.Get(k)
+		assert.Equal(t, ref[k], v)
+		assert.Equal(t, i, m.Rank(k))
+		selected, _, _ := m.Select(i)
+		assert.Equal(t, k, selected)
+	}
+	for probe := -1; probe <= 201; probe++ {
+		i := sort.SearchInts(keys, probe)
+		assert.Equal(t, i, m.Rank(probe))
+
+		ceiling, _, ok := m.Ceiling(probe)
+		assert.Equal(t, i < len(keys), ok)
+		if ok {
+			assert.Equal(t, keys[i], ceiling)
+		}
+
+		floorIdx := i - 1
+		if i < len(keys) && keys[i] == probe {
+			floorIdx = i
+		}
+		floor, _, ok := m.Floor(probe)
+		assert.Equal(t, floorIdx >= 0, ok)
+		if ok {
+			assert.Equal(t, keys[floorIdx], floor)
+		}
+	}
+}